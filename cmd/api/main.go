@@ -31,6 +31,13 @@ func main() {
 	}
 	log.Println("✓ Migrations completed successfully")
 
+	if len(os.Args) > 1 {
+		runCommand(os.Args[1], os.Args[2:], db, zapLogger)
+		return
+	}
+
+	bootstrapAdmin(db)
+
 	server := app.NewServer(db.Conn, zapLogger)
 
 	if err := server.Start(); err != nil {
@@ -52,3 +59,51 @@ func main() {
 
 	log.Println("✓ Server exited cleanly")
 }
+
+// runCommand sunucuyu başlatmadan tek seferlik alt komutları çalıştırır:
+//
+//	api bootstrap           - hiç ADMIN yoksa yönetici hesabı oluşturur
+//	api fixtures <dosya>    - demo verisini yükler (sadece APP_ENV=development)
+//	api dlq <alt komut>     - dead-letter queue'ları listeler, replay eder veya siler
+func runCommand(name string, args []string, db *database.Database, zapLogger logger.Logger) {
+	switch name {
+	case "bootstrap":
+		bootstrapAdmin(db)
+	case "fixtures":
+		if !app.FixturesAllowed() {
+			log.Fatal("✗ Fixtures can only be loaded when APP_ENV=development")
+		}
+		if len(args) != 1 {
+			log.Fatal("✗ Usage: api fixtures <file.yaml|file.json>")
+		}
+		fixtures, err := app.ReadFixtures(args[0])
+		if err != nil {
+			log.Fatalf("✗ Failed to read fixtures: %v", err)
+		}
+		if err := app.LoadFixtures(context.Background(), db.Conn, fixtures); err != nil {
+			log.Fatalf("✗ Failed to load fixtures: %v", err)
+		}
+		log.Printf("✓ Fixtures loaded: %d users, %d tasks\n", len(fixtures.Users), len(fixtures.Tasks))
+	case "dlq":
+		if err := app.RunDLQCommand(context.Background(), args, zapLogger, os.Stdout); err != nil {
+			log.Fatalf("✗ DLQ command failed: %v", err)
+		}
+	default:
+		log.Fatalf("✗ Unknown command %q (expected bootstrap, fixtures or dlq)", name)
+	}
+}
+
+func bootstrapAdmin(db *database.Database) {
+	result, err := app.BootstrapAdmin(context.Background(), db.Conn)
+	if err != nil {
+		log.Fatalf("✗ Admin bootstrap failed: %v", err)
+	}
+	if result == nil {
+		return
+	}
+
+	log.Printf("✓ Bootstrap admin created. Username: %s\n", result.Username)
+	if os.Getenv("BOOTSTRAP_ADMIN_PASSWORD") == "" {
+		log.Printf("  Generated password (shown only once): %s\n", result.Password)
+	}
+}
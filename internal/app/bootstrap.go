@@ -0,0 +1,90 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	userRepo "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/repository"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const defaultBootstrapUsername = "admin"
+
+// BootstrapResult ilk çalıştırmada oluşturulan yönetici hesabını taşır.
+// Password sadece bu noktada düz metin olarak bilinir.
+type BootstrapResult struct {
+	Username string
+	Password string
+}
+
+// BootstrapAdmin hiç ADMIN kullanıcısı yoksa gerçek bir yönetici hesabı oluşturur.
+// Kullanıcı adı BOOTSTRAP_ADMIN_USERNAME, şifre BOOTSTRAP_ADMIN_PASSWORD ile verilebilir;
+// şifre verilmezse rastgele üretilir. Zaten bir ADMIN varsa nil döner.
+func BootstrapAdmin(ctx context.Context, db *sqlx.DB) (*BootstrapResult, error) {
+	repo := userRepo.NewPostgresRepository(db)
+
+	count, err := repo.CountByRole(ctx, "ADMIN")
+	if err != nil {
+		return nil, fmt.Errorf("failed to count admin users: %w", err)
+	}
+	if count > 0 {
+		return nil, nil
+	}
+
+	username := os.Getenv("BOOTSTRAP_ADMIN_USERNAME")
+	if username == "" {
+		username = defaultBootstrapUsername
+	}
+
+	existing, err := repo.GetByUsername(ctx, username)
+	if err == nil {
+		return nil, fmt.Errorf("bootstrap user %q already exists with role %s", username, existing.Role)
+	}
+	if err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to check bootstrap user: %w", err)
+	}
+
+	password := os.Getenv("BOOTSTRAP_ADMIN_PASSWORD")
+	if password != "" && !validation.GetPasswordPolicy().Allows(password) {
+		return nil, fmt.Errorf("BOOTSTRAP_ADMIN_PASSWORD does not satisfy the password policy")
+	}
+	if password == "" {
+		password, err = generatePassword()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate password: %w", err)
+		}
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), 14)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	admin := &userDomain.User{
+		Username: username,
+		Password: string(hashedPassword),
+		Role:     "ADMIN",
+		Ad:       "Sistem",
+		Soyad:    "Yöneticisi",
+	}
+	if err := repo.Create(ctx, admin); err != nil {
+		return nil, fmt.Errorf("failed to create admin user: %w", err)
+	}
+
+	return &BootstrapResult{Username: username, Password: password}, nil
+}
+
+func generatePassword() (string, error) {
+	buf := make([]byte, 18)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
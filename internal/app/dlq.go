@@ -0,0 +1,113 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/dlq/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/dlq/service"
+)
+
+const dlqUsage = `usage:
+  api dlq topics
+  api dlq list <topic> [limit] [cursor]
+  api dlq replay <topic> <id>...
+  api dlq replay-range <topic> <from|-> <to|+>
+  api dlq delete <topic> <id>
+  api dlq purge <topic>`
+
+// RunDLQCommand dead-letter queue'ları API ile aynı service üzerinden yönetir ve sonucu
+// out'a yazar.
+func RunDLQCommand(ctx context.Context, args []string, zapLogger logger.Logger, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(dlqUsage)
+	}
+
+	dlq := NewDeadLetterQueue()
+	defer dlq.Close()
+	svc := service.NewDLQService(dlq, zapLogger)
+
+	cmd, args := args[0], args[1:]
+	switch {
+	case cmd == "topics" && len(args) == 0:
+		topics, err := svc.ListTopics(ctx)
+		if err != nil {
+			return err
+		}
+		tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "TOPIC\tDEPTH")
+		for _, t := range topics {
+			fmt.Fprintf(tw, "%s\t%d\n", t.Topic, t.Depth)
+		}
+		return tw.Flush()
+
+	case cmd == "list" && len(args) >= 1 && len(args) <= 3:
+		filter := &domain.MessageFilter{}
+		if len(args) > 1 {
+			limit, err := strconv.Atoi(args[1])
+			if err != nil || limit < 1 || limit > domain.MaxListLimit {
+				return fmt.Errorf("limit must be between 1 and %d", domain.MaxListLimit)
+			}
+			filter.Limit = limit
+		}
+		if len(args) > 2 {
+			filter.Cursor = args[2]
+		}
+		page, err := svc.ListMessages(ctx, args[0], filter)
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(page)
+
+	case cmd == "replay" && len(args) >= 2:
+		result, err := svc.Replay(ctx, args[0], &domain.ReplayRequest{IDs: args[1:]})
+		if err != nil {
+			return err
+		}
+		return writeReplayResult(out, result)
+
+	case cmd == "replay-range" && len(args) == 3:
+		result, err := svc.Replay(ctx, args[0], &domain.ReplayRequest{From: args[1], To: args[2]})
+		if err != nil {
+			return err
+		}
+		return writeReplayResult(out, result)
+
+	case cmd == "delete" && len(args) == 2:
+		if err := svc.DeleteMessage(ctx, args[0], args[1]); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintf(out, "Deleted message %s from %s DLQ\n", args[1], args[0])
+		return err
+
+	case cmd == "purge" && len(args) == 1:
+		result, err := svc.Purge(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(out, "Purged %d messages from %s DLQ\n", result.Deleted, args[0])
+		return err
+
+	default:
+		return errors.New(dlqUsage)
+	}
+}
+
+func writeReplayResult(out io.Writer, result *domain.ReplayResult) error {
+	fmt.Fprintf(out, "Replayed %d messages\n", result.Replayed)
+	for _, id := range result.NotFound {
+		fmt.Fprintf(out, "Not found: %s\n", id)
+	}
+	if result.HasMore {
+		fmt.Fprintln(out, "More messages remain in the range; run the command again")
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/database"
+	taskDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	taskRepo "github.com/M1ralai/go-modular-monolith-template/internal/modules/task/repository"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	userRepo "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/repository"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.yaml.in/yaml/v2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Fixtures geliştirme ortamı için demo verisidir. Task'lardaki kullanıcılar
+// username ile referans verilir ve dosyada ya da veritabanında bulunmalıdır.
+type Fixtures struct {
+	Users []FixtureUser `json:"users" yaml:"users"`
+	Tasks []FixtureTask `json:"tasks" yaml:"tasks"`
+}
+
+type FixtureUser struct {
+	Username string `json:"username" yaml:"username"`
+	Password string `json:"password" yaml:"password"`
+	Role     string `json:"role" yaml:"role"`
+	Ad       string `json:"ad" yaml:"ad"`
+	Soyad    string `json:"soyad" yaml:"soyad"`
+	Telefon  string `json:"telefon" yaml:"telefon"`
+	Email    string `json:"email" yaml:"email"`
+}
+
+type FixtureTask struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	Priority    string   `json:"priority" yaml:"priority"`
+	Status      string   `json:"status" yaml:"status"`
+	CreatedBy   string   `json:"created_by" yaml:"created_by"`
+	Assignees   []string `json:"assignees" yaml:"assignees"`
+}
+
+// FixturesAllowed fixtures modunun sadece APP_ENV=development iken çalışmasını sağlar.
+func FixturesAllowed() bool {
+	return os.Getenv("APP_ENV") == "development"
+}
+
+func ReadFixtures(path string) (*Fixtures, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures := &Fixtures{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(data, fixtures)
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(data, fixtures)
+	default:
+		return nil, fmt.Errorf("unsupported fixture file %q (expected .json, .yaml or .yml)", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse fixtures: %w", err)
+	}
+	return fixtures, nil
+}
+
+// LoadFixtures kullanıcıları (mevcut olanları atlayarak) ve task'ları atamalarıyla
+// birlikte oluşturur. Task'lar her çalıştırmada yeniden eklenir.
+func LoadFixtures(ctx context.Context, db *sqlx.DB, fixtures *Fixtures) error {
+	users := userRepo.NewPostgresRepository(db)
+	tasks := taskRepo.NewPostgresTaskRepository(db)
+	assignments := taskRepo.NewPostgresAssignmentRepository(db)
+	activities := taskRepo.NewPostgresActivityRepository(db)
+	workflow := taskRepo.NewPostgresWorkflowRepository(db)
+	uow := database.NewUnitOfWork(db)
+
+	userIDs := make(map[string]uuid.UUID)
+	for _, fu := range fixtures.Users {
+		user, err := users.GetByUsername(ctx, fu.Username)
+		if err == nil {
+			userIDs[user.Username] = user.Id
+			continue
+		}
+		if err != sql.ErrNoRows {
+			return err
+		}
+
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(fu.Password), 14)
+		if err != nil {
+			return err
+		}
+		if err := users.Create(ctx, &userDomain.User{
+			Username: fu.Username,
+			Password: string(hashedPassword),
+			Role:     fu.Role,
+			Ad:       fu.Ad,
+			Soyad:    fu.Soyad,
+			Telefon:  fu.Telefon,
+			Email:    fu.Email,
+		}); err != nil {
+			return fmt.Errorf("failed to create fixture user %q: %w", fu.Username, err)
+		}
+
+		user, err = users.GetByUsername(ctx, fu.Username)
+		if err != nil {
+			return err
+		}
+		userIDs[user.Username] = user.Id
+	}
+
+	lookupUser := func(username string) (uuid.UUID, error) {
+		if id, ok := userIDs[username]; ok {
+			return id, nil
+		}
+		user, err := users.GetByUsername(ctx, username)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("fixture user %q not found: %w", username, err)
+		}
+		userIDs[username] = user.Id
+		return user.Id, nil
+	}
+
+	initialState, err := workflow.GetInitialState(ctx)
+	if err != nil {
+		return err
+	}
+	if initialState == nil {
+		return taskDomain.ErrNoInitialState{}
+	}
+
+	for _, ft := range fixtures.Tasks {
+		createdBy, err := lookupUser(ft.CreatedBy)
+		if err != nil {
+			return err
+		}
+
+		status := initialState.Key
+		if ft.Status != "" {
+			state, err := workflow.GetState(ctx, taskDomain.TaskStatus(ft.Status))
+			if err != nil {
+				return err
+			}
+			if state == nil {
+				return fmt.Errorf("fixture task %q has unknown status %q", ft.Title, ft.Status)
+			}
+			status = state.Key
+		}
+
+		priority := taskDomain.TaskPriority(ft.Priority)
+		if priority == "" {
+			priority = taskDomain.TaskPriorityMedium
+		}
+
+		assigneeIDs := make([]uuid.UUID, 0, len(ft.Assignees))
+		for _, username := range ft.Assignees {
+			id, err := lookupUser(username)
+			if err != nil {
+				return err
+			}
+			assigneeIDs = append(assigneeIDs, id)
+		}
+
+		now := time.Now()
+		task := &taskDomain.Task{
+			ID:          uuid.New(),
+			Title:       ft.Title,
+			Description: ft.Description,
+			Status:      status,
+			Priority:    priority,
+			CreatedBy:   createdBy,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+
+		err = uow.Do(ctx, func(tx *sqlx.Tx) error {
+			if err := tasks.Create(ctx, tx, task); err != nil {
+				return err
+			}
+			if err := activities.Create(ctx, tx, &taskDomain.Activity{
+				ID:        uuid.New(),
+				TaskID:    task.ID,
+				UserID:    createdBy,
+				Action:    taskDomain.ActivityTaskCreated,
+				Changes:   taskDomain.ActivityMetadata(map[string]any{"title": task.Title, "status": task.Status}),
+				CreatedAt: now,
+			}); err != nil {
+				return err
+			}
+
+			for _, userID := range assigneeIDs {
+				assignment := &taskDomain.TaskAssignment{
+					ID:        uuid.New(),
+					TaskID:    task.ID,
+					UserID:    userID,
+					CreatedAt: now,
+				}
+				if err := assignments.Create(ctx, tx, assignment); err != nil {
+					return err
+				}
+				if err := activities.Create(ctx, tx, &taskDomain.Activity{
+					ID:     uuid.New(),
+					TaskID: task.ID,
+					UserID: userID,
+					Action: taskDomain.ActivityAssignmentAdded,
+					Changes: taskDomain.ActivityMetadata(map[string]any{
+						"assignment_id": assignment.ID,
+						"assignee_id":   assignment.UserID,
+					}),
+					CreatedAt: now,
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create fixture task %q: %w", ft.Title, err)
+		}
+	}
+
+	return nil
+}
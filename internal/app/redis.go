@@ -0,0 +1,22 @@
+package app
+
+import (
+	"os"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/eventbus"
+)
+
+// redisConfig REDIS_ADDR (varsayılan localhost:6379) ve REDIS_PASSWORD değerlerini okur.
+func redisConfig() (addr, password string) {
+	addr = os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		addr = "localhost:6379"
+	}
+	return addr, os.Getenv("REDIS_PASSWORD")
+}
+
+// NewDeadLetterQueue sunucuyla aynı Redis'e bağlanan DLQ istemcisini döner; CLI komutları
+// tarafından kullanılır.
+func NewDeadLetterQueue() eventbus.DeadLetterQueue {
+	return eventbus.NewRedisDLQ(redisConfig())
+}
@@ -9,13 +9,18 @@ import (
 	"time"
 
 	"github.com/M1ralai/go-modular-monolith-template/internal/common/events"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/database"
 	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/eventbus"
 	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
 	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/middleware"
 	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/outbox"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/token"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
+	authDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
 	authHttp "github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/http"
+	authProvider "github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/provider"
+	authRepo "github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/repository"
 	authService "github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/service"
 
 	userHttp "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/http"
@@ -26,6 +31,18 @@ import (
 	taskRepo "github.com/M1ralai/go-modular-monolith-template/internal/modules/task/repository"
 	taskService "github.com/M1ralai/go-modular-monolith-template/internal/modules/task/service"
 
+	teamHttp "github.com/M1ralai/go-modular-monolith-template/internal/modules/team/http"
+	teamRepo "github.com/M1ralai/go-modular-monolith-template/internal/modules/team/repository"
+	teamService "github.com/M1ralai/go-modular-monolith-template/internal/modules/team/service"
+
+	rbacDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/rbac/domain"
+	rbacHttp "github.com/M1ralai/go-modular-monolith-template/internal/modules/rbac/http"
+	rbacRepo "github.com/M1ralai/go-modular-monolith-template/internal/modules/rbac/repository"
+	rbacService "github.com/M1ralai/go-modular-monolith-template/internal/modules/rbac/service"
+
+	dlqHttp "github.com/M1ralai/go-modular-monolith-template/internal/modules/dlq/http"
+	dlqService "github.com/M1ralai/go-modular-monolith-template/internal/modules/dlq/service"
+
 	healthHttp "github.com/M1ralai/go-modular-monolith-template/internal/modules/health/http"
 
 	notificationListener "github.com/M1ralai/go-modular-monolith-template/internal/modules/notification/listener"
@@ -42,36 +59,116 @@ type Server struct {
 
 func NewServer(db *sqlx.DB, zapLogger logger.LoggerWithMiddleware) *Server {
 
-	redisAddr := os.Getenv("REDIS_ADDR")
-	if redisAddr == "" {
-		redisAddr = "localhost:6379"
-	}
-	redisPassword := os.Getenv("REDIS_PASSWORD")
+	redisAddr, redisPassword := redisConfig()
 	eventBus := eventbus.NewRedisBus(redisAddr, redisPassword, "task-service-group")
 
+	deadLetterQueue := eventbus.NewRedisDLQ(redisAddr, redisPassword)
+	go eventbus.WatchDLQDepth(context.Background(), deadLetterQueue, 30*time.Second)
+	log.Println("✓ DLQ depth monitor started")
+
 	outboxRepo := outbox.NewPostgresRepository(db)
 	outboxProcessor := outbox.NewProcessor(outboxRepo, eventBus, 5*time.Second, 100)
 	go outboxProcessor.Start()
 	log.Println("✓ Outbox processor started")
 
 	userRepository := userRepo.NewPostgresRepository(db)
-	userSvc := userService.NewService(userRepository, zapLogger)
-	userHandler := userHttp.NewHandler(userSvc)
 
-	authSvc := authService.NewService(userRepository, zapLogger)
-	authHandler := authHttp.NewHandler(authSvc)
+	unitOfWork := database.NewUnitOfWork(db)
+
+	tokenManager, err := token.NewManagerFromEnv()
+	if err != nil {
+		log.Fatalf("✗ Failed to load JWT keys: %v", err)
+	}
+
+	roleRepository := rbacRepo.NewPostgresRoleRepository(db)
+	roleSvc := rbacService.NewRoleService(roleRepository, zapLogger)
+	roleHandler := rbacHttp.NewHandler(roleSvc)
+	authorizer := middleware.NewAuthorizer(roleSvc)
 
 	taskRepository := taskRepo.NewPostgresTaskRepository(db)
 	assignmentRepository := taskRepo.NewPostgresAssignmentRepository(db)
 	activityRepository := taskRepo.NewPostgresActivityRepository(db)
+	workflowRepository := taskRepo.NewPostgresWorkflowRepository(db)
+	scopeRepository := taskRepo.NewPostgresScopeRepository(db)
+	scopeLookupRepository := taskRepo.NewPostgresScopeLookupRepository(db)
+	tagRepository := taskRepo.NewPostgresTagRepository(db)
+	commentRepository := taskRepo.NewPostgresCommentRepository(db)
+	relationRepository := taskRepo.NewPostgresTaskRelationRepository(db)
 
 	userProvider := userRepo.NewUserProviderAdapter(userRepository)
-	taskSvc := taskService.NewTaskService(taskRepository, assignmentRepository, activityRepository, userProvider, outboxRepo, zapLogger)
+	taskSvc := taskService.NewTaskService(taskRepository, workflowRepository, relationRepository, assignmentRepository, scopeRepository, activityRepository, userProvider, outboxRepo, unitOfWork, zapLogger)
 	taskHandler := taskHttp.NewHandler(taskSvc)
 
+	userSvc := userService.NewService(userRepository, taskSvc, roleSvc, unitOfWork, zapLogger)
+	userHandler := userHttp.NewHandler(userSvc)
+
+	refreshTokenRepository := authRepo.NewPostgresRefreshTokenRepository(db)
+	revokedTokenRepository := authRepo.NewPostgresRevokedTokenRepository(db)
+	var loginAttemptStore authDomain.LoginAttemptStore
+	if os.Getenv("LOGIN_ATTEMPT_STORE") == "memory" {
+		loginAttemptStore = authRepo.NewMemoryLoginAttemptStore()
+	} else {
+		loginAttemptStore = authRepo.NewPostgresLoginAttemptStore(db)
+	}
+
+	passwordResetRepository := authRepo.NewPostgresPasswordResetRepository(db)
+	mfaRepository := authRepo.NewPostgresMFARepository(db)
+	identityRepository := authRepo.NewPostgresIdentityRepository(db)
+	oidcStateRepository := authRepo.NewPostgresOIDCStateRepository(db)
+	authenticators, defaultProvider, err := authProvider.FromEnv(userRepository, zapLogger)
+	if err != nil {
+		log.Fatalf("✗ Failed to configure identity providers: %v", err)
+	}
+	authSvc := authService.NewService(userRepository, userSvc, refreshTokenRepository, revokedTokenRepository, passwordResetRepository, mfaRepository, identityRepository, oidcStateRepository, authenticators, defaultProvider, loginAttemptStore, outboxRepo, tokenManager, unitOfWork, zapLogger)
+	authHandler := authHttp.NewHandler(authSvc)
+	go authSvc.PruneLoginAttempts(context.Background(), 10*time.Minute)
+
+	patRepository := authRepo.NewPostgresPATRepository(db)
+	patSvc := authService.NewPATService(patRepository, userRepository, roleSvc, zapLogger)
+	patHandler := authHttp.NewPATHandler(patSvc)
+	authMiddleware := middleware.AuthMiddleware(tokenManager, authSvc, patSvc, authSvc)
+
+	workflowSvc := taskService.NewWorkflowService(workflowRepository, zapLogger)
+	workflowHandler := taskHttp.NewWorkflowHandler(workflowSvc)
+
+	scopeSvc := taskService.NewScopeService(scopeRepository, scopeLookupRepository, assignmentRepository, activityRepository, unitOfWork, zapLogger)
+	scopeHandler := taskHttp.NewScopeHandler(scopeSvc)
+
+	tagSvc := taskService.NewTagService(tagRepository, taskRepository, activityRepository, unitOfWork, zapLogger)
+	tagHandler := taskHttp.NewTagHandler(tagSvc)
+
+	activitySvc := taskService.NewActivityService(activityRepository, taskRepository, userProvider, zapLogger)
+	activityHandler := taskHttp.NewActivityHandler(activitySvc)
+
+	commentSvc := taskService.NewCommentService(commentRepository, taskRepository, activityRepository, userProvider, outboxRepo, unitOfWork, zapLogger)
+	commentHandler := taskHttp.NewCommentHandler(commentSvc)
+
+	relationSvc := taskService.NewRelationService(relationRepository, taskRepository, activityRepository, unitOfWork, zapLogger)
+	relationHandler := taskHttp.NewRelationHandler(relationSvc)
+
+	teamRepository := teamRepo.NewPostgresTeamRepository(db)
+	teamSvc := teamService.NewTeamService(teamRepository, userRepository, zapLogger)
+	teamHandler := teamHttp.NewHandler(teamSvc)
+
+	teamAssignmentRepository := taskRepo.NewPostgresTeamAssignmentRepository(db)
+	teamProvider := teamRepo.NewTeamProviderAdapter(teamRepository)
+	teamAssignmentSvc := taskService.NewTeamAssignmentService(teamAssignmentRepository, taskRepository, assignmentRepository, activityRepository, teamProvider, outboxRepo, unitOfWork, zapLogger)
+	teamAssignmentHandler := taskHttp.NewTeamAssignmentHandler(teamAssignmentSvc)
+
 	taskListener := notificationListener.NewTaskEventListener()
 	eventBus.Subscribe(context.Background(), events.TopicTaskAssigned, taskListener.HandleTaskAssigned)
-	log.Println("✓ Task event listener subscribed to:", events.TopicTaskAssigned)
+	eventBus.Subscribe(context.Background(), events.TopicTaskTeamAssigned, taskListener.HandleTaskTeamAssigned)
+	eventBus.Subscribe(context.Background(), events.TopicTaskStatusChanged, taskListener.HandleTaskStatusChanged)
+	eventBus.Subscribe(context.Background(), events.TopicTaskDone, taskListener.HandleTaskDone)
+	eventBus.Subscribe(context.Background(), events.TopicCommentMention, taskListener.HandleCommentMention)
+	log.Println("✓ Task event listener subscribed to:", events.TopicTaskAssigned, events.TopicTaskTeamAssigned, events.TopicTaskStatusChanged, events.TopicTaskDone, events.TopicCommentMention)
+
+	userListener := notificationListener.NewUserEventListener()
+	eventBus.Subscribe(context.Background(), events.TopicPasswordReset, userListener.HandlePasswordResetRequested)
+	log.Println("✓ User event listener subscribed to:", events.TopicPasswordReset)
+
+	dlqSvc := dlqService.NewDLQService(deadLetterQueue, zapLogger)
+	dlqHandler := dlqHttp.NewHandler(dlqSvc)
 
 	healthHandler := healthHttp.NewHandler()
 
@@ -89,24 +186,118 @@ func NewServer(db *sqlx.DB, zapLogger logger.LoggerWithMiddleware) *Server {
 	})
 
 	router.Handle("/metrics", promhttp.Handler()).Methods("GET")
+	router.HandleFunc("/.well-known/jwks.json", authHandler.JWKS).Methods("GET")
 	router.HandleFunc("/login", authHandler.Login).Methods("POST")
+	router.HandleFunc("/auth/refresh", authHandler.Refresh).Methods("POST")
+	router.HandleFunc("/auth/providers", authHandler.Providers).Methods("GET")
+	router.HandleFunc("/auth/oidc/authorize", authHandler.AuthorizeOIDC).Methods("GET")
+	router.HandleFunc("/auth/oidc/callback", authHandler.OIDCCallback).Methods("POST")
+	router.HandleFunc("/auth/mfa/verify", authHandler.VerifyMFA).Methods("POST")
+	router.HandleFunc("/auth/password/forgot", authHandler.ForgotPassword).Methods("POST")
+	router.HandleFunc("/auth/password/reset", authHandler.ResetPassword).Methods("POST")
+	router.Handle("/auth/logout", authMiddleware(middleware.RequireSession(authHandler.Logout))).Methods("POST")
 	router.HandleFunc("/health", healthHandler.HealthCheck).Methods("GET")
 
 	api := router.PathPrefix("/api").Subrouter()
-	api.Use(middleware.AuthMiddleware)
-
-	api.HandleFunc("/users", userHandler.UsersGet).Methods("GET")
-	api.HandleFunc("/users", userHandler.UserPost).Methods("POST")
-	api.HandleFunc("/users/{id}", userHandler.UserGetByID).Methods("GET")
-	api.HandleFunc("/users/{id}", userHandler.UserDelete).Methods("DELETE")
-
-	api.HandleFunc("/tasks", taskHandler.ListTasks).Methods("GET")
-	api.HandleFunc("/tasks", taskHandler.CreateTask).Methods("POST")
-	api.HandleFunc("/tasks/{id}", taskHandler.GetTask).Methods("GET")
-	api.HandleFunc("/tasks/{id}/status", taskHandler.UpdateTaskStatus).Methods("PATCH")
-	api.HandleFunc("/tasks/{id}/assignments", taskHandler.GetTaskAssignments).Methods("GET")
-	api.HandleFunc("/tasks/{id}/assignments", taskHandler.AssignTask).Methods("POST")
-	api.HandleFunc("/tasks/assignments/{id}", taskHandler.UnassignTask).Methods("DELETE")
+	api.Use(authMiddleware)
+
+	can := authorizer.RequirePermission
+
+	api.HandleFunc("/users", can(rbacDomain.PermUserRead)(userHandler.UsersGet)).Methods("GET")
+	api.HandleFunc("/users", can(rbacDomain.PermUserManage)(userHandler.UserPost)).Methods("POST")
+	// Kullanıcının kendi hesabına ait route'lar için oturum yeterlidir, ek yetki gerekmez;
+	// kişisel erişim token'larıyla kullanılamazlar.
+	session := middleware.RequireSession
+	api.HandleFunc("/users/import", can(rbacDomain.PermUserManage)(userHandler.UsersImport)).Methods("POST")
+	api.HandleFunc("/users/export", can(rbacDomain.PermUserRead)(userHandler.UsersExport)).Methods("GET")
+	api.HandleFunc("/users/me", session(userHandler.MeGet)).Methods("GET")
+	api.HandleFunc("/users/me", session(userHandler.MePatch)).Methods("PATCH")
+	api.HandleFunc("/users/me/password", session(authHandler.ChangePassword)).Methods("POST")
+	api.HandleFunc("/users/me/mfa/enroll", session(authHandler.EnrollMFA)).Methods("POST")
+	api.HandleFunc("/users/me/mfa/activate", session(authHandler.ActivateMFA)).Methods("POST")
+	api.HandleFunc("/users/me/mfa", session(authHandler.DisableMFA)).Methods("DELETE")
+	api.HandleFunc("/users/me/tokens", session(patHandler.ListTokens)).Methods("GET")
+	api.HandleFunc("/users/me/tokens", session(patHandler.CreateToken)).Methods("POST")
+	api.HandleFunc("/users/me/tokens/{id}", session(patHandler.DeleteToken)).Methods("DELETE")
+	api.HandleFunc("/users/{id}", can(rbacDomain.PermUserRead)(userHandler.UserGetByID)).Methods("GET")
+	api.HandleFunc("/users/{id}", can(rbacDomain.PermUserManage)(userHandler.UserPatch)).Methods("PATCH")
+	api.HandleFunc("/users/{id}", can(rbacDomain.PermUserManage)(userHandler.UserDelete)).Methods("DELETE")
+	api.HandleFunc("/users/{id}/role", can(rbacDomain.PermRoleManage)(userHandler.UserRolePut)).Methods("PUT")
+	api.HandleFunc("/users/{id}/suspend", can(rbacDomain.PermUserManage)(userHandler.UserSuspend)).Methods("POST")
+	api.HandleFunc("/users/{id}/reactivate", can(rbacDomain.PermUserManage)(userHandler.UserReactivate)).Methods("POST")
+	api.HandleFunc("/users/{id}/unlock", can(rbacDomain.PermUserManage)(authHandler.UnlockUser)).Methods("POST")
+	api.HandleFunc("/users/{id}/sessions", can(rbacDomain.PermUserManage)(authHandler.RevokeUserSessions)).Methods("DELETE")
+	api.HandleFunc("/users/{id}/mfa", can(rbacDomain.PermUserManage)(authHandler.ResetMFA)).Methods("DELETE")
+	api.HandleFunc("/users/{id}/activities", can(rbacDomain.PermUserRead)(activityHandler.ListUserActivities)).Methods("GET")
+
+	api.HandleFunc("/tasks", can(rbacDomain.PermTaskRead)(taskHandler.ListTasks)).Methods("GET")
+	api.HandleFunc("/tasks", can(rbacDomain.PermTaskCreate)(taskHandler.CreateTask)).Methods("POST")
+	api.HandleFunc("/tasks/{id}", can(rbacDomain.PermTaskRead)(taskHandler.GetTask)).Methods("GET")
+	api.HandleFunc("/tasks/{id}", can(rbacDomain.PermTaskEdit)(taskHandler.UpdateTask)).Methods("PATCH")
+	api.HandleFunc("/tasks/{id}", can(rbacDomain.PermTaskDelete)(taskHandler.DeleteTask)).Methods("DELETE")
+	api.HandleFunc("/tasks/{id}/restore", can(rbacDomain.PermTaskDelete)(taskHandler.RestoreTask)).Methods("POST")
+	api.HandleFunc("/tasks/{id}/parent", can(rbacDomain.PermTaskEdit)(relationHandler.SetParent)).Methods("PUT")
+	api.HandleFunc("/tasks/{id}/dependencies", can(rbacDomain.PermTaskRead)(relationHandler.GetDependencies)).Methods("GET")
+	api.HandleFunc("/tasks/{id}/dependencies", can(rbacDomain.PermTaskEdit)(relationHandler.AddDependency)).Methods("POST")
+	api.HandleFunc("/tasks/{id}/dependencies/{blockerId}", can(rbacDomain.PermTaskEdit)(relationHandler.RemoveDependency)).Methods("DELETE")
+	api.HandleFunc("/tasks/{id}/activities", can(rbacDomain.PermTaskRead)(activityHandler.ListTaskActivities)).Methods("GET")
+	api.HandleFunc("/tasks/{id}/comments", can(rbacDomain.PermTaskRead)(commentHandler.ListComments)).Methods("GET")
+	api.HandleFunc("/tasks/{id}/comments", can(rbacDomain.PermTaskComment)(commentHandler.CreateComment)).Methods("POST")
+	api.HandleFunc("/tasks/{id}/comments/{commentId}", can(rbacDomain.PermTaskComment)(commentHandler.UpdateComment)).Methods("PATCH")
+	api.HandleFunc("/tasks/{id}/comments/{commentId}", can(rbacDomain.PermTaskComment)(commentHandler.DeleteComment)).Methods("DELETE")
+	api.HandleFunc("/tasks/{id}/comments/{commentId}/history", can(rbacDomain.PermTaskRead)(commentHandler.GetCommentHistory)).Methods("GET")
+	api.HandleFunc("/tasks/{id}/tags", can(rbacDomain.PermTaskRead)(tagHandler.GetTaskTags)).Methods("GET")
+	api.HandleFunc("/tasks/{id}/tags", can(rbacDomain.PermTaskEdit)(tagHandler.AddTagToTask)).Methods("POST")
+	api.HandleFunc("/tasks/{id}/tags/{tagId}", can(rbacDomain.PermTaskEdit)(tagHandler.RemoveTagFromTask)).Methods("DELETE")
+	api.HandleFunc("/tasks/{id}/status", can(rbacDomain.PermTaskStatus)(taskHandler.UpdateTaskStatus)).Methods("PATCH")
+	api.HandleFunc("/tasks/{id}/assignments", can(rbacDomain.PermTaskRead)(taskHandler.GetTaskAssignments)).Methods("GET")
+	api.HandleFunc("/tasks/{id}/assignments", can(rbacDomain.PermTaskAssign)(taskHandler.AssignTask)).Methods("POST")
+	api.HandleFunc("/tasks/assignments/{id}", can(rbacDomain.PermTaskAssign)(taskHandler.UnassignTask)).Methods("DELETE")
+	api.HandleFunc("/tasks/assignments/{id}/scopes", can(rbacDomain.PermTaskAssign)(scopeHandler.AddScopeToAssignment)).Methods("POST")
+	api.HandleFunc("/tasks/assignments/{id}/scopes/{scopeId}", can(rbacDomain.PermTaskAssign)(scopeHandler.RemoveScopeFromAssignment)).Methods("DELETE")
+	api.HandleFunc("/tasks/{id}/teams", can(rbacDomain.PermTaskRead)(teamAssignmentHandler.GetTaskTeams)).Methods("GET")
+	api.HandleFunc("/tasks/{id}/teams", can(rbacDomain.PermTaskAssign)(teamAssignmentHandler.AssignTeam)).Methods("POST")
+	api.HandleFunc("/tasks/team-assignments/{id}", can(rbacDomain.PermTaskAssign)(teamAssignmentHandler.UnassignTeam)).Methods("DELETE")
+	// Takım liderliği yetkiyle değil takım üyeliğiyle belirlenir; lider kontrolü service'dedir.
+	api.HandleFunc("/tasks/team-assignments/{id}/distribute", can(rbacDomain.PermTaskDistribute)(teamAssignmentHandler.DistributeTask)).Methods("POST")
+
+	api.HandleFunc("/teams", can(rbacDomain.PermTaskRead)(teamHandler.ListTeams)).Methods("GET")
+	api.HandleFunc("/teams", can(rbacDomain.PermTeamManage)(teamHandler.CreateTeam)).Methods("POST")
+	api.HandleFunc("/teams/{id}", can(rbacDomain.PermTaskRead)(teamHandler.GetTeam)).Methods("GET")
+	api.HandleFunc("/teams/{id}", can(rbacDomain.PermTeamManage)(teamHandler.UpdateTeam)).Methods("PATCH")
+	api.HandleFunc("/teams/{id}", can(rbacDomain.PermTeamManage)(teamHandler.DeleteTeam)).Methods("DELETE")
+	api.HandleFunc("/teams/{id}/members/{userId}", can(rbacDomain.PermTeamManage)(teamHandler.SetMember)).Methods("PUT")
+	api.HandleFunc("/teams/{id}/members/{userId}", can(rbacDomain.PermTeamManage)(teamHandler.RemoveMember)).Methods("DELETE")
+
+	api.HandleFunc("/scopes", can(rbacDomain.PermTaskRead)(scopeHandler.ListScopes)).Methods("GET")
+	api.HandleFunc("/scopes", can(rbacDomain.PermScopeManage)(scopeHandler.CreateScope)).Methods("POST")
+	api.HandleFunc("/scopes/{id}", can(rbacDomain.PermScopeManage)(scopeHandler.UpdateScope)).Methods("PATCH")
+	api.HandleFunc("/scopes/{id}", can(rbacDomain.PermScopeManage)(scopeHandler.DeleteScope)).Methods("DELETE")
+
+	api.HandleFunc("/tags", can(rbacDomain.PermTaskRead)(tagHandler.ListTags)).Methods("GET")
+	api.HandleFunc("/tags", can(rbacDomain.PermTagManage)(tagHandler.CreateTag)).Methods("POST")
+	api.HandleFunc("/tags/{id}", can(rbacDomain.PermTagManage)(tagHandler.RenameTag)).Methods("PATCH")
+	api.HandleFunc("/tags/{id}", can(rbacDomain.PermTagManage)(tagHandler.DeleteTag)).Methods("DELETE")
+	api.HandleFunc("/tags/{id}/merge", can(rbacDomain.PermTagManage)(tagHandler.MergeTag)).Methods("POST")
+
+	api.HandleFunc("/workflow", can(rbacDomain.PermTaskRead)(workflowHandler.GetWorkflow)).Methods("GET")
+	api.HandleFunc("/workflow/states", can(rbacDomain.PermWorkflowManage)(workflowHandler.CreateState)).Methods("POST")
+	api.HandleFunc("/workflow/states/{key}", can(rbacDomain.PermWorkflowManage)(workflowHandler.DeleteState)).Methods("DELETE")
+	api.HandleFunc("/workflow/transitions", can(rbacDomain.PermWorkflowManage)(workflowHandler.CreateTransition)).Methods("POST")
+	api.HandleFunc("/workflow/transitions/{id}", can(rbacDomain.PermWorkflowManage)(workflowHandler.DeleteTransition)).Methods("DELETE")
+
+	api.HandleFunc("/roles", can(rbacDomain.PermRoleManage)(roleHandler.ListRoles)).Methods("GET")
+	api.HandleFunc("/roles", can(rbacDomain.PermRoleManage)(roleHandler.CreateRole)).Methods("POST")
+	api.HandleFunc("/roles/{name}", can(rbacDomain.PermRoleManage)(roleHandler.GetRole)).Methods("GET")
+	api.HandleFunc("/roles/{name}", can(rbacDomain.PermRoleManage)(roleHandler.UpdateRole)).Methods("PATCH")
+	api.HandleFunc("/roles/{name}", can(rbacDomain.PermRoleManage)(roleHandler.DeleteRole)).Methods("DELETE")
+	api.HandleFunc("/permissions", can(rbacDomain.PermRoleManage)(roleHandler.ListPermissions)).Methods("GET")
+
+	api.HandleFunc("/dlq", can(rbacDomain.PermEventManage)(dlqHandler.ListTopics)).Methods("GET")
+	api.HandleFunc("/dlq/{topic}", can(rbacDomain.PermEventManage)(dlqHandler.ListMessages)).Methods("GET")
+	api.HandleFunc("/dlq/{topic}", can(rbacDomain.PermEventManage)(dlqHandler.Purge)).Methods("DELETE")
+	api.HandleFunc("/dlq/{topic}/replay", can(rbacDomain.PermEventManage)(dlqHandler.Replay)).Methods("POST")
+	api.HandleFunc("/dlq/{topic}/messages/{id}", can(rbacDomain.PermEventManage)(dlqHandler.DeleteMessage)).Methods("DELETE")
 
 	port := os.Getenv("API_PORT")
 	if port == "" {
@@ -1,14 +1,105 @@
 package events
 
+import "time"
+
 const (
-	TopicTaskAssigned = "task_assigned_stream"
-	TopicTaskDone     = "task_done_stream"
+	TopicTaskAssigned      = "task_assigned_stream"
+	TopicTaskTeamAssigned  = "task_team_assigned_stream"
+	TopicTaskDone          = "task_done_stream"
+	TopicTaskStatusChanged = "task_status_changed_stream"
+	TopicCommentMention    = "comment_mention_stream"
+	TopicLoginFailed       = "login_failed_stream"
+	TopicAccountLocked     = "account_locked_stream"
+	TopicPasswordReset     = "password_reset_requested_stream"
 )
 
+// TaskAssignedEvent tek bir kullanıcıya yapılan atamada yayınlanır. Atama bir takım
+// liderinin dağıtımıyla yapıldıysa TeamID ve TeamName doludur.
 type TaskAssignedEvent struct {
 	TaskID    string `json:"task_id"`
 	TaskTitle string `json:"task_title"`
 	UserID    string `json:"user_id"`
 	UserEmail string `json:"user_email"`
 	UserName  string `json:"user_name"`
+	TeamID    string `json:"team_id,omitempty"`
+	TeamName  string `json:"team_name,omitempty"`
+}
+
+// TaskTeamAssignedEvent task bir takıma atandığında yayınlanır. Recipients, atama anında
+// takımın aktif üyeleridir; her üyeye ayrı bildirim gönderilir.
+type TaskTeamAssignedEvent struct {
+	TaskID         string      `json:"task_id"`
+	TaskTitle      string      `json:"task_title"`
+	TeamID         string      `json:"team_id"`
+	TeamName       string      `json:"team_name"`
+	AssignedBy     string      `json:"assigned_by"`
+	AssignedByName string      `json:"assigned_by_name"`
+	Recipients     []Recipient `json:"recipients"`
+}
+
+type TaskStatusChangedEvent struct {
+	TaskID    string    `json:"task_id"`
+	TaskTitle string    `json:"task_title"`
+	OldStatus string    `json:"old_status"`
+	NewStatus string    `json:"new_status"`
+	ActorID   string    `json:"actor_id"`
+	ActorName string    `json:"actor_name"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// TaskDoneEvent task workflow'un final durumlarından birine geçtiğinde yayınlanır. Recipients, task'ı oluşturan
+// kullanıcı ile atanmış kullanıcıları içerir.
+type TaskDoneEvent struct {
+	TaskID          string      `json:"task_id"`
+	TaskTitle       string      `json:"task_title"`
+	CompletedBy     string      `json:"completed_by"`
+	CompletedByName string      `json:"completed_by_name"`
+	CompletedAt     time.Time   `json:"completed_at"`
+	Recipients      []Recipient `json:"recipients"`
+}
+
+type Recipient struct {
+	UserID    string `json:"user_id"`
+	UserName  string `json:"user_name"`
+	UserEmail string `json:"user_email"`
+}
+
+// CommentMentionEvent bir yorumda @username ile anılan kullanıcılar için yayınlanır.
+// Yorumu yazan kullanıcı kendini andıysa Recipients'a eklenmez.
+type CommentMentionEvent struct {
+	TaskID     string      `json:"task_id"`
+	TaskTitle  string      `json:"task_title"`
+	CommentID  string      `json:"comment_id"`
+	AuthorID   string      `json:"author_id"`
+	AuthorName string      `json:"author_name"`
+	Excerpt    string      `json:"excerpt"`
+	Recipients []Recipient `json:"recipients"`
+}
+
+// LoginFailedEvent her başarısız girişte yayınlanır. UserID, kullanıcı adı
+// sistemde yoksa boştur.
+type LoginFailedEvent struct {
+	UserID      string    `json:"user_id,omitempty"`
+	Username    string    `json:"username"`
+	IP          string    `json:"ip"`
+	Failures    int       `json:"failures"`
+	AttemptedAt time.Time `json:"attempted_at"`
+}
+
+type AccountLockedEvent struct {
+	UserID      string    `json:"user_id,omitempty"`
+	Username    string    `json:"username"`
+	IP          string    `json:"ip"`
+	Failures    int       `json:"failures"`
+	LockedUntil time.Time `json:"locked_until"`
+}
+
+// PasswordResetRequestedEvent şifre sıfırlama bağlantısını kullanıcıya iletmek için
+// yayınlanır. Token düz metindir ve sadece bildirim kanalına gönderilmelidir.
+type PasswordResetRequestedEvent struct {
+	UserID    string    `json:"user_id"`
+	Username  string    `json:"username"`
+	UserEmail string    `json:"user_email"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
 }
@@ -1,5 +1,7 @@
 package stype
 
+import "encoding/json"
+
 type APIResponse struct {
 	Success   bool         `json:"success"`
 	Message   string       `json:"message"`
@@ -25,3 +27,33 @@ type LoginResponse struct {
 	Ad       string `json:"ad"`
 	Soyad    string `json:"soyad"`
 }
+
+// Nullable PATCH isteklerinde alanın hiç gönderilmemesi ile null gönderilmesini ayırt eder.
+// Alan JSON'da yoksa Set false kalır; null gönderilirse Set true ve Value nil olur.
+type Nullable[T any] struct {
+	Set   bool
+	Value *T
+}
+
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	n.Set = true
+	if string(data) == "null" {
+		n.Value = nil
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	n.Value = &v
+	return nil
+}
+
+// ValueOrNil validator'ın alttaki değeri doğrulayabilmesi için kullanılır.
+func (n Nullable[T]) ValueOrNil() any {
+	if n.Value == nil {
+		return nil
+	}
+	return *n.Value
+}
@@ -15,6 +15,9 @@ type ctxKey string
 const RoleKey ctxKey = "role"
 const UsernameKey ctxKey = "username"
 const UserIDKey ctxKey = "user_id"
+const TokenIDKey ctxKey = "token_id"
+const TokenExpiresAtKey ctxKey = "token_expires_at"
+const TokenScopesKey ctxKey = "token_scopes"
 
 func ReadJson[T any](r *http.Request, validate *validator.Validate) (T, error) {
 	var res T
@@ -90,6 +93,44 @@ func GetUserIDFromContext(ctx interface{}) string {
 	return ""
 }
 
+func GetRoleFromContext(ctx interface{}) string {
+	if c, ok := ctx.(interface{ Value(any) any }); ok {
+		if role, ok := c.Value(RoleKey).(string); ok {
+			return role
+		}
+	}
+	return ""
+}
+
+func GetTokenIDFromContext(ctx interface{}) string {
+	if c, ok := ctx.(interface{ Value(any) any }); ok {
+		if tokenID, ok := c.Value(TokenIDKey).(string); ok {
+			return tokenID
+		}
+	}
+	return ""
+}
+
+func GetTokenExpiresAtFromContext(ctx interface{}) time.Time {
+	if c, ok := ctx.(interface{ Value(any) any }); ok {
+		if expiresAt, ok := c.Value(TokenExpiresAtKey).(time.Time); ok {
+			return expiresAt
+		}
+	}
+	return time.Time{}
+}
+
+// GetTokenScopesFromContext kişisel erişim token'ıyla gelen isteklerde token'ın yetkilerini
+// döner; ok false ise istek normal oturum (JWT) ile yapılmıştır.
+func GetTokenScopesFromContext(ctx interface{}) ([]string, bool) {
+	if c, ok := ctx.(interface{ Value(any) any }); ok {
+		if scopes, ok := c.Value(TokenScopesKey).([]string); ok {
+			return scopes, true
+		}
+	}
+	return nil, false
+}
+
 func ReturnError(w http.ResponseWriter, code, message, details string) {
 	var status int
 	switch code {
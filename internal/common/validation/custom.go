@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"regexp"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
@@ -9,3 +10,28 @@ import (
 func BlankValidator(fl validator.FieldLevel) bool {
 	return strings.TrimSpace(fl.Field().String()) != ""
 }
+
+var slugPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// SlugValidator küçük harfle başlayan, sadece küçük harf, rakam ve alt çizgi içeren anahtarları kabul eder.
+func SlugValidator(fl validator.FieldLevel) bool {
+	return slugPattern.MatchString(fl.Field().String())
+}
+
+var phonePattern = regexp.MustCompile(`^\+?[0-9][0-9 ()-]*$`)
+
+// PhoneValidator başında isteğe bağlı "+" olan, boşluk, tire ve parantez içerebilen 7-15
+// haneli telefon numaralarını kabul eder.
+func PhoneValidator(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	if len(value) > 20 || !phonePattern.MatchString(value) {
+		return false
+	}
+	digits := 0
+	for _, c := range value {
+		if c >= '0' && c <= '9' {
+			digits++
+		}
+	}
+	return digits >= 7 && digits <= 15
+}
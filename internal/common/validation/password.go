@@ -0,0 +1,102 @@
+package validation
+
+import (
+	"bufio"
+	_ "embed"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+//go:embed common_passwords.txt
+var commonPasswordsFile string
+
+// PasswordPolicy şifre kurallarıdır. MinClasses; küçük harf, büyük harf, rakam ve
+// sembolden kaç farklı türün bulunması gerektiğini belirtir.
+type PasswordPolicy struct {
+	MinLength  int
+	MinClasses int
+	denylist   map[string]struct{}
+}
+
+var passwordPolicy PasswordPolicy
+
+// PasswordPolicyFromEnv PASSWORD_MIN_LENGTH (varsayılan 10) ve PASSWORD_MIN_CLASSES
+// (varsayılan 3) değişkenlerinden politikayı yükler.
+func PasswordPolicyFromEnv() PasswordPolicy {
+	policy := PasswordPolicy{
+		MinLength:  envInt("PASSWORD_MIN_LENGTH", 10),
+		MinClasses: envInt("PASSWORD_MIN_CLASSES", 3),
+		denylist:   make(map[string]struct{}),
+	}
+	if policy.MinClasses > 4 {
+		policy.MinClasses = 4
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(commonPasswordsFile))
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			policy.denylist[strings.ToLower(line)] = struct{}{}
+		}
+	}
+	return policy
+}
+
+// GetPasswordPolicy Init ile yüklenen politikayı döner.
+func GetPasswordPolicy() PasswordPolicy {
+	if validate == nil {
+		Init()
+	}
+	return passwordPolicy
+}
+
+// Allows şifrenin uzunluk, karakter türü ve yaygın şifre kurallarına uyup uymadığını döner.
+func (p PasswordPolicy) Allows(password string) bool {
+	if len([]rune(password)) < p.MinLength {
+		return false
+	}
+	if _, common := p.denylist[strings.ToLower(password)]; common {
+		return false
+	}
+
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	classes := 0
+	for _, present := range []bool{lower, upper, digit, symbol} {
+		if present {
+			classes++
+		}
+	}
+	return classes >= p.MinClasses
+}
+
+func (p PasswordPolicy) description() string {
+	return "en az " + strconv.Itoa(p.MinLength) + " karakter olmalı, küçük harf, büyük harf, rakam ve sembolden en az " +
+		strconv.Itoa(p.MinClasses) + " türünü içermeli ve yaygın bir şifre olmamalıdır"
+}
+
+func PasswordValidator(fl validator.FieldLevel) bool {
+	return passwordPolicy.Allows(fl.Field().String())
+}
+
+func envInt(key string, fallback int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil && value > 0 {
+		return value
+	}
+	return fallback
+}
@@ -4,7 +4,9 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/stype"
 	"github.com/go-playground/locales/tr"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
@@ -33,6 +35,9 @@ func Init() {
 			return name
 		})
 
+		validate.RegisterCustomTypeFunc(nullableValue,
+			stype.Nullable[string]{}, stype.Nullable[int]{}, stype.Nullable[time.Time]{})
+
 		tr_translations.RegisterDefaultTranslations(validate, trans)
 
 		validate.RegisterTranslation("required", trans, func(ut ut.Translator) error {
@@ -41,9 +46,41 @@ func Init() {
 			t, _ := ut.T("required", fe.Field())
 			return t
 		})
+
+		validate.RegisterValidation("slug", SlugValidator)
+		validate.RegisterTranslation("slug", trans, func(ut ut.Translator) error {
+			return ut.Add("slug", "{0} küçük harfle başlamalı ve sadece küçük harf, rakam ve alt çizgi içermelidir", true)
+		}, func(ut ut.Translator, fe validator.FieldError) string {
+			t, _ := ut.T("slug", fe.Field())
+			return t
+		})
+
+		validate.RegisterValidation("phone", PhoneValidator)
+		validate.RegisterTranslation("phone", trans, func(ut ut.Translator) error {
+			return ut.Add("phone", "{0} geçerli bir telefon numarası olmalıdır (7-15 hane, ör. +90 555 123 45 67)", true)
+		}, func(ut ut.Translator, fe validator.FieldError) string {
+			t, _ := ut.T("phone", fe.Field())
+			return t
+		})
+
+		passwordPolicy = PasswordPolicyFromEnv()
+		validate.RegisterValidation("password", PasswordValidator)
+		validate.RegisterTranslation("password", trans, func(ut ut.Translator) error {
+			return ut.Add("password", "{0} "+passwordPolicy.description(), true)
+		}, func(ut ut.Translator, fe validator.FieldError) string {
+			t, _ := ut.T("password", fe.Field())
+			return t
+		})
 	})
 }
 
+func nullableValue(field reflect.Value) interface{} {
+	if n, ok := field.Interface().(interface{ ValueOrNil() any }); ok {
+		return n.ValueOrNil()
+	}
+	return nil
+}
+
 func Get() *validator.Validate {
 	if validate == nil {
 		Init()
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_tasks_deleted_at;
+DROP INDEX IF EXISTS idx_tasks_due_date;
+DROP INDEX IF EXISTS idx_tasks_priority;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE tasks DROP COLUMN IF EXISTS estimated_effort;
+ALTER TABLE tasks DROP COLUMN IF EXISTS due_date;
+ALTER TABLE tasks DROP COLUMN IF EXISTS priority;
+ALTER TABLE tasks DROP COLUMN IF EXISTS description;
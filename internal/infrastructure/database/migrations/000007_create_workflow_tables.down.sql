@@ -0,0 +1,3 @@
+ALTER TABLE tasks DROP CONSTRAINT IF EXISTS fk_tasks_status;
+DROP TABLE IF EXISTS workflow_transitions;
+DROP TABLE IF EXISTS workflow_states;
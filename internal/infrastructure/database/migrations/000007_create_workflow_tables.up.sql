@@ -0,0 +1,38 @@
+-- Configurable task workflow
+CREATE TABLE IF NOT EXISTS workflow_states (
+    key VARCHAR(20) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    is_initial BOOLEAN NOT NULL DEFAULT FALSE,
+    is_final BOOLEAN NOT NULL DEFAULT FALSE,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_workflow_states_single_initial ON workflow_states(is_initial) WHERE is_initial;
+
+CREATE TABLE IF NOT EXISTS workflow_transitions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    from_state VARCHAR(20) NOT NULL REFERENCES workflow_states(key) ON DELETE CASCADE,
+    to_state VARCHAR(20) NOT NULL REFERENCES workflow_states(key) ON DELETE CASCADE,
+    required_role VARCHAR(20),
+    UNIQUE (from_state, to_state)
+);
+
+COMMENT ON COLUMN workflow_transitions.required_role IS 'NULL = any authenticated user may perform the transition';
+
+INSERT INTO workflow_states (key, name, is_initial, is_final, position) VALUES
+    ('todo', 'Yapılacak', TRUE, FALSE, 0),
+    ('in_progress', 'Devam Ediyor', FALSE, FALSE, 1),
+    ('done', 'Tamamlandı', FALSE, TRUE, 2)
+ON CONFLICT (key) DO NOTHING;
+
+INSERT INTO workflow_transitions (from_state, to_state, required_role) VALUES
+    ('todo', 'in_progress', NULL),
+    ('todo', 'done', NULL),
+    ('in_progress', 'todo', NULL),
+    ('in_progress', 'done', NULL),
+    ('done', 'in_progress', 'ADMIN'),
+    ('done', 'todo', 'ADMIN')
+ON CONFLICT (from_state, to_state) DO NOTHING;
+
+ALTER TABLE tasks ADD CONSTRAINT fk_tasks_status FOREIGN KEY (status) REFERENCES workflow_states(key);
@@ -0,0 +1,2 @@
+ALTER TABLE scopes DROP COLUMN IF EXISTS created_at;
+ALTER TABLE scopes DROP COLUMN IF EXISTS permissions;
@@ -0,0 +1,10 @@
+-- Scope permissions restrict what an assignee may do on a task
+ALTER TABLE scopes ADD COLUMN IF NOT EXISTS permissions TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE scopes ADD COLUMN IF NOT EXISTS created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+
+COMMENT ON COLUMN scopes.permissions IS 'Allowed actions for assignees holding this scope (e.g. status:update, task:edit). Empty = read-only';
+
+INSERT INTO scopes (name, permissions) VALUES
+    ('read-only', '{}'),
+    ('contributor', '{status:update,task:edit}')
+ON CONFLICT (name) DO NOTHING;
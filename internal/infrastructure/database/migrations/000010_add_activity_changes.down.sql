@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_task_activities_user_timeline;
+DROP INDEX IF EXISTS idx_task_activities_task_timeline;
+
+ALTER TABLE task_activities DROP COLUMN IF EXISTS changes;
@@ -0,0 +1,5 @@
+-- Activity timeline: field diffs and action metadata
+ALTER TABLE task_activities ADD COLUMN IF NOT EXISTS changes JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+CREATE INDEX IF NOT EXISTS idx_task_activities_task_timeline ON task_activities(task_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_task_activities_user_timeline ON task_activities(user_id, created_at DESC, id DESC);
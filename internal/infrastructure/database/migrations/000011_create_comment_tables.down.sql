@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS task_comment_edits;
+DROP TABLE IF EXISTS task_comments;
@@ -0,0 +1,26 @@
+-- Task comments with threaded replies and edit history
+CREATE TABLE IF NOT EXISTS task_comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    parent_id UUID REFERENCES task_comments(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES users(id),
+    body TEXT NOT NULL,
+    mentions UUID[] NOT NULL DEFAULT '{}',
+    edited_at TIMESTAMP WITH TIME ZONE,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_task_comments_task_id ON task_comments(task_id, created_at);
+CREATE INDEX idx_task_comments_parent_id ON task_comments(parent_id);
+
+CREATE TABLE IF NOT EXISTS task_comment_edits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    comment_id UUID NOT NULL REFERENCES task_comments(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    edited_by UUID NOT NULL REFERENCES users(id),
+    edited_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_task_comment_edits_comment_id ON task_comment_edits(comment_id);
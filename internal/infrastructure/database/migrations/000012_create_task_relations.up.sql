@@ -0,0 +1,16 @@
+-- Subtask hierarchy
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES tasks(id) ON DELETE SET NULL;
+ALTER TABLE tasks ADD CONSTRAINT chk_tasks_parent_not_self CHECK (parent_id IS NULL OR parent_id <> id);
+
+CREATE INDEX IF NOT EXISTS idx_tasks_parent_id ON tasks(parent_id);
+
+-- Blocks / blocked-by links
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    blocker_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    blocked_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (blocker_id, blocked_id),
+    CONSTRAINT chk_task_dependencies_not_self CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX idx_task_dependencies_blocked_id ON task_dependencies(blocked_id);
@@ -0,0 +1,69 @@
+-- Role based access control
+CREATE TABLE IF NOT EXISTS roles (
+    name VARCHAR(20) PRIMARY KEY,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    is_system BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS permissions (
+    key VARCHAR(50) PRIMARY KEY,
+    description VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role VARCHAR(20) NOT NULL REFERENCES roles(name) ON UPDATE CASCADE ON DELETE CASCADE,
+    permission VARCHAR(50) NOT NULL REFERENCES permissions(key) ON DELETE CASCADE,
+    PRIMARY KEY (role, permission)
+);
+
+INSERT INTO permissions (key, description) VALUES
+    ('user:read', 'Kullanıcıları ve aktivitelerini görüntüleme'),
+    ('user:manage', 'Kullanıcı oluşturma ve silme'),
+    ('task:read', 'Task, yorum, tag, scope ve workflow görüntüleme'),
+    ('task:create', 'Task oluşturma'),
+    ('task:edit', 'Task alanlarını, tag''lerini ve ilişkilerini düzenleme'),
+    ('task:delete', 'Task silme ve geri yükleme'),
+    ('task:status', 'Task durumunu değiştirme'),
+    ('task:assign', 'Task ataması ve atama scope''larını yönetme'),
+    ('task:comment', 'Task yorumu yazma, düzenleme ve silme'),
+    ('tag:manage', 'Tag oluşturma, yeniden adlandırma, silme ve birleştirme'),
+    ('scope:manage', 'Scope oluşturma, güncelleme ve silme'),
+    ('workflow:manage', 'Workflow durum ve geçişlerini yönetme'),
+    ('role:manage', 'Rolleri ve rol yetkilerini yönetme')
+ON CONFLICT (key) DO NOTHING;
+
+INSERT INTO roles (name, description, is_system) VALUES
+    ('ADMIN', 'Sistem yöneticisi', TRUE),
+    ('SEKRETER', 'Task ve atama yönetimi', FALSE),
+    ('USER', 'Standart kullanıcı', FALSE)
+ON CONFLICT (name) DO NOTHING;
+
+-- Roles already used by existing accounts are kept without permissions
+INSERT INTO roles (name)
+SELECT DISTINCT role FROM users
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission)
+SELECT 'ADMIN', key FROM permissions
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('SEKRETER', 'user:read'),
+    ('SEKRETER', 'task:read'),
+    ('SEKRETER', 'task:create'),
+    ('SEKRETER', 'task:edit'),
+    ('SEKRETER', 'task:delete'),
+    ('SEKRETER', 'task:status'),
+    ('SEKRETER', 'task:assign'),
+    ('SEKRETER', 'task:comment'),
+    ('SEKRETER', 'tag:manage'),
+    ('USER', 'user:read'),
+    ('USER', 'task:read'),
+    ('USER', 'task:create'),
+    ('USER', 'task:edit'),
+    ('USER', 'task:status'),
+    ('USER', 'task:comment')
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE users ADD CONSTRAINT fk_users_role FOREIGN KEY (role) REFERENCES roles(name) ON UPDATE CASCADE;
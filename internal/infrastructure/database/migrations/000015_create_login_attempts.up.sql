@@ -0,0 +1,9 @@
+-- Failed login tracking for brute-force protection (keys: "user:<username>", "ip:<address>")
+CREATE TABLE IF NOT EXISTS login_attempts (
+    key VARCHAR(200) PRIMARY KEY,
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_until TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_login_attempts_last_failed_at ON login_attempts(last_failed_at);
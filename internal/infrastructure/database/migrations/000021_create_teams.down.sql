@@ -0,0 +1,8 @@
+DELETE FROM permissions WHERE key IN ('team:manage', 'task:distribute');
+
+DROP INDEX IF EXISTS idx_task_assignments_team_id;
+ALTER TABLE task_assignments DROP COLUMN IF EXISTS team_id;
+
+DROP TABLE IF EXISTS task_team_assignments;
+DROP TABLE IF EXISTS team_members;
+DROP TABLE IF EXISTS teams;
@@ -0,0 +1,48 @@
+-- Teams group users under one or more team leads. Tasks can be assigned to a team and
+-- leads distribute them to members; those member assignments keep the team in team_id
+CREATE TABLE IF NOT EXISTS teams (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL UNIQUE,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS team_members (
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id),
+    role VARCHAR(10) NOT NULL DEFAULT 'member' CHECK (role IN ('member', 'lead')),
+    joined_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_members_user_id ON team_members(user_id);
+
+CREATE TABLE IF NOT EXISTS task_team_assignments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    assigned_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (task_id, team_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_team_assignments_team_id ON task_team_assignments(team_id);
+
+ALTER TABLE task_assignments ADD COLUMN IF NOT EXISTS team_id UUID REFERENCES teams(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_task_assignments_team_id ON task_assignments(team_id);
+
+INSERT INTO permissions (key, description) VALUES
+    ('team:manage', 'Takım oluşturma, güncelleme, silme ve üyelerini yönetme'),
+    ('task:distribute', 'Takım liderinin takıma atanan task''ları üyelere dağıtması')
+ON CONFLICT (key) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission)
+SELECT 'ADMIN', key FROM permissions WHERE key IN ('team:manage', 'task:distribute')
+ON CONFLICT DO NOTHING;
+
+-- Leads are usually regular users; the lead check itself happens in the task service
+INSERT INTO role_permissions (role, permission)
+SELECT name, 'task:distribute' FROM roles WHERE name IN ('SEKRETER', 'USER')
+ON CONFLICT DO NOTHING;
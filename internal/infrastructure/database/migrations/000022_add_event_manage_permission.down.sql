@@ -0,0 +1 @@
+DELETE FROM permissions WHERE key = 'event:manage';
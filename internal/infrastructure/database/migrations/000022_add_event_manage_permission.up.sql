@@ -0,0 +1,7 @@
+INSERT INTO permissions (key, description) VALUES
+    ('event:manage', 'Dead-letter queue mesajlarını görüntüleme, yeniden oynatma ve silme')
+ON CONFLICT (key) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission)
+SELECT 'ADMIN', key FROM permissions WHERE key = 'event:manage'
+ON CONFLICT DO NOTHING;
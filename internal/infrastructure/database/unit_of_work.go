@@ -0,0 +1,35 @@
+package database
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// UnitOfWork bir servis çağrısındaki yazma işlemlerini tek transaction içinde çalıştırır.
+// fn hata dönerse ya da panic olursa transaction geri alınır, aksi halde commit edilir.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(tx *sqlx.Tx) error) error
+}
+
+type sqlxUnitOfWork struct {
+	db *sqlx.DB
+}
+
+func NewUnitOfWork(db *sqlx.DB) UnitOfWork {
+	return &sqlxUnitOfWork{db: db}
+}
+
+func (u *sqlxUnitOfWork) Do(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	tx, err := u.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,290 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/metrics"
+	"github.com/redis/go-redis/v9"
+)
+
+// MaxReplayRange tek bir aralık replay çağrısında kaynak stream'e geri yazılacak en fazla
+// mesaj sayısıdır.
+const MaxReplayRange = 1000
+
+// DeadLetter moveToDLQ tarafından DLQ'ya taşınmış bir mesajdır. Hata bilgileri eski
+// kayıtlarda bulunmayabilir.
+type DeadLetter struct {
+	ID         string          `json:"id"`
+	OriginalID string          `json:"original_id,omitempty"`
+	Attempts   int             `json:"attempts,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	FailedAt   *time.Time      `json:"failed_at,omitempty"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// DLQTopic DLQ'sunda mesaj bulunan bir topic'tir; Topic kaynak stream'in adıdır.
+type DLQTopic struct {
+	Topic string `json:"topic"`
+	Depth int64  `json:"depth"`
+}
+
+type DeadLetterQueue interface {
+	Topics(ctx context.Context) ([]DLQTopic, error)
+	List(ctx context.Context, topic, after string, limit int64) ([]DeadLetter, error)
+	// Replay verilen mesajları kaynak stream'e yeni mesaj olarak yazar ve DLQ'dan siler;
+	// DLQ'da bulunan ve replay edilen ID'leri döner.
+	Replay(ctx context.Context, topic string, ids []string) ([]string, error)
+	// ReplayRange from ve to (dahil) arasındaki mesajları en fazla limit adet replay eder.
+	ReplayRange(ctx context.Context, topic, from, to string, limit int) (int, bool, error)
+	Delete(ctx context.Context, topic string, ids []string) (int64, error)
+	Purge(ctx context.Context, topic string) (int64, error)
+	Close() error
+}
+
+type redisDLQ struct {
+	client *redis.Client
+}
+
+func NewRedisDLQ(addr, password string) DeadLetterQueue {
+	if addr == "" {
+		log.Fatal("REDIS_ADDR is required")
+	}
+
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: password,
+	})
+
+	if err := rdb.Ping(context.Background()).Err(); err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+
+	return &redisDLQ{client: rdb}
+}
+
+func (d *redisDLQ) Topics(ctx context.Context) ([]DLQTopic, error) {
+	topics := []DLQTopic{}
+
+	iter := d.client.ScanType(ctx, 0, "*"+DLQSuffix, 100, "stream").Iterator()
+	for iter.Next(ctx) {
+		key := iter.Val()
+		depth, err := d.client.XLen(ctx, key).Result()
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, DLQTopic{Topic: strings.TrimSuffix(key, DLQSuffix), Depth: depth})
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
+
+func (d *redisDLQ) List(ctx context.Context, topic, after string, limit int64) ([]DeadLetter, error) {
+	if err := d.requireTopic(ctx, topic); err != nil {
+		return nil, err
+	}
+
+	start := "-"
+	if after != "" {
+		start = "(" + after
+	}
+
+	entries, err := d.client.XRangeN(ctx, topic+DLQSuffix, start, "+", limit).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	letters := make([]DeadLetter, 0, len(entries))
+	for _, entry := range entries {
+		letters = append(letters, toDeadLetter(entry))
+	}
+	return letters, nil
+}
+
+func (d *redisDLQ) Replay(ctx context.Context, topic string, ids []string) ([]string, error) {
+	if err := d.requireTopic(ctx, topic); err != nil {
+		return nil, err
+	}
+
+	replayed := []string{}
+	for _, id := range ids {
+		entries, err := d.client.XRange(ctx, topic+DLQSuffix, id, id).Result()
+		if err != nil {
+			return replayed, err
+		}
+		if len(entries) == 0 {
+			continue
+		}
+		if err := d.replay(ctx, topic, entries); err != nil {
+			return replayed, err
+		}
+		replayed = append(replayed, id)
+	}
+
+	return replayed, nil
+}
+
+func (d *redisDLQ) ReplayRange(ctx context.Context, topic, from, to string, limit int) (int, bool, error) {
+	if err := d.requireTopic(ctx, topic); err != nil {
+		return 0, false, err
+	}
+
+	replayed := 0
+	start := from
+	for replayed < limit {
+		count := int64(limit - replayed)
+		if count > BatchSize {
+			count = BatchSize
+		}
+
+		entries, err := d.client.XRangeN(ctx, topic+DLQSuffix, start, to, count).Result()
+		if err != nil {
+			return replayed, false, err
+		}
+		if len(entries) == 0 {
+			return replayed, false, nil
+		}
+
+		if err := d.replay(ctx, topic, entries); err != nil {
+			return replayed, false, err
+		}
+		replayed += len(entries)
+		start = "(" + entries[len(entries)-1].ID
+	}
+
+	rest, err := d.client.XRangeN(ctx, topic+DLQSuffix, start, to, 1).Result()
+	if err != nil {
+		return replayed, false, err
+	}
+	return replayed, len(rest) > 0, nil
+}
+
+// replay mesajları sadece event_data ile kaynak stream'e yazar; yeni mesajın teslimat sayısı
+// sıfırdan başlar. Yazma ve DLQ'dan silme tek MULTI/EXEC içinde yapılır.
+func (d *redisDLQ) replay(ctx context.Context, topic string, entries []redis.XMessage) error {
+	ids := make([]string, 0, len(entries))
+	_, err := d.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, entry := range entries {
+			pipe.XAdd(ctx, &redis.XAddArgs{
+				Stream: topic,
+				Values: map[string]any{"event_data": entry.Values["event_data"]},
+			})
+			ids = append(ids, entry.ID)
+		}
+		pipe.XDel(ctx, topic+DLQSuffix, ids...)
+		return nil
+	})
+	return err
+}
+
+func (d *redisDLQ) Delete(ctx context.Context, topic string, ids []string) (int64, error) {
+	if err := d.requireTopic(ctx, topic); err != nil {
+		return 0, err
+	}
+	return d.client.XDel(ctx, topic+DLQSuffix, ids...).Result()
+}
+
+func (d *redisDLQ) Purge(ctx context.Context, topic string) (int64, error) {
+	if err := d.requireTopic(ctx, topic); err != nil {
+		return 0, err
+	}
+
+	var length *redis.IntCmd
+	_, err := d.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		length = pipe.XLen(ctx, topic+DLQSuffix)
+		pipe.Del(ctx, topic+DLQSuffix)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return length.Val(), nil
+}
+
+func (d *redisDLQ) Close() error {
+	return d.client.Close()
+}
+
+func (d *redisDLQ) requireTopic(ctx context.Context, topic string) error {
+	n, err := d.client.Exists(ctx, topic+DLQSuffix).Result()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrDLQNotFound
+	}
+	return nil
+}
+
+func toDeadLetter(entry redis.XMessage) DeadLetter {
+	letter := DeadLetter{ID: entry.ID}
+
+	letter.OriginalID, _ = entry.Values["original_id"].(string)
+	letter.Error, _ = entry.Values["error"].(string)
+	if v, ok := entry.Values["attempts"].(string); ok {
+		letter.Attempts, _ = strconv.Atoi(v)
+	}
+	if v, ok := entry.Values["failed_at"].(string); ok {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			letter.FailedAt = &t
+		}
+	}
+
+	payload, _ := entry.Values["event_data"].(string)
+	if json.Valid([]byte(payload)) {
+		letter.Payload = json.RawMessage(payload)
+	} else {
+		letter.Payload, _ = json.Marshal(payload)
+	}
+
+	return letter
+}
+
+// WatchDLQDepth DLQ derinliklerini interval aralıklarla eventbus_dlq_depth gauge'una yazar.
+// Önce güncel değerler yazılır, ardından artık DLQ'su olmayan topic'lerin label'ları silinir;
+// böylece scrape sırasında gauge boş görünmez.
+func WatchDLQDepth(ctx context.Context, dlq DeadLetterQueue, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	seen := map[string]bool{}
+	for {
+		topics, err := dlq.Topics(ctx)
+		if err != nil {
+			log.Printf("Failed to read DLQ depth: %v", err)
+		} else {
+			current := make(map[string]bool, len(topics))
+			for _, t := range topics {
+				metrics.DLQDepth.WithLabelValues(t.Topic).Set(float64(t.Depth))
+				current[t.Topic] = true
+			}
+			for topic := range seen {
+				if !current[topic] {
+					metrics.DLQDepth.DeleteLabelValues(topic)
+				}
+			}
+			seen = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+var ErrDLQNotFound = &DLQNotFoundError{}
+
+type DLQNotFoundError struct{}
+
+func (e *DLQNotFoundError) Error() string {
+	return "dead-letter stream not found"
+}
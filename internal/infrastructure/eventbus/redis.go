@@ -12,9 +12,17 @@ import (
 )
 
 const (
+	// MaxRetries ilk denemeden sonra yapılacak yeniden deneme sayısıdır; bunlar da başarısız
+	// olursa mesaj DLQ'ya taşınır.
 	MaxRetries = 3
 	DLQSuffix  = "_dlq"
 	BatchSize  = 50
+
+	// RetryBaseDelay başarısız mesajın ilk yeniden denemesinden önce pending kalacağı
+	// süredir (visibility timeout); sonraki denemelerde ikiye katlanır.
+	RetryBaseDelay = 5 * time.Second
+	// RetryInterval pending mesajların ne sıklıkla taranacağıdır.
+	RetryInterval = time.Second
 )
 
 type EventBus interface {
@@ -83,7 +91,9 @@ func (r *redisBus) Subscribe(ctx context.Context, topic string, handler func(pay
 func (r *redisBus) listenLoop(ctx context.Context, topic string, handler func([]byte) error) {
 	log.Printf("Redis Stream listening: Topic=%s Group=%s Worker=%s", topic, r.group, r.worker)
 
-	r.processPendingMessages(ctx, topic, handler)
+	// Yeniden deneme aynı goroutine'de yapılır; bir topic'in handler'ı kendisiyle eş zamanlı çalışmaz.
+	r.retryPendingMessages(ctx, topic, handler)
+	lastRetry := time.Now()
 
 	for {
 		select {
@@ -91,6 +101,11 @@ func (r *redisBus) listenLoop(ctx context.Context, topic string, handler func([]
 			log.Printf("Stopping listener for topic: %s", topic)
 			return
 		default:
+			if time.Since(lastRetry) >= RetryInterval {
+				r.retryPendingMessages(ctx, topic, handler)
+				lastRetry = time.Now()
+			}
+
 			entries, err := r.client.XReadGroup(ctx, &redis.XReadGroupArgs{
 				Group:    r.group,
 				Consumer: r.worker,
@@ -109,14 +124,17 @@ func (r *redisBus) listenLoop(ctx context.Context, topic string, handler func([]
 			}
 
 			for _, entry := range entries[0].Messages {
-				r.handleMessage(ctx, topic, entry, handler)
+				r.handleMessage(ctx, topic, entry, 1, handler)
 			}
 		}
 	}
 }
 
-func (r *redisBus) handleMessage(ctx context.Context, topic string, entry redis.XMessage, handler func([]byte) error) {
-	payload := entry.Values["event_data"].(string)
+// handleMessage başarılı mesajı onaylar. Başarısız mesaj onaylanmadan pending bırakılır ve
+// retryPendingMessages tarafından tekrar alınır; attempts MaxRetries yeniden denemeyi
+// aştığında mesaj DLQ'ya taşınır.
+func (r *redisBus) handleMessage(ctx context.Context, topic string, entry redis.XMessage, attempts int64, handler func([]byte) error) {
+	payload, _ := entry.Values["event_data"].(string)
 
 	err := handler([]byte(payload))
 	if err == nil {
@@ -124,59 +142,106 @@ func (r *redisBus) handleMessage(ctx context.Context, topic string, entry redis.
 		return
 	}
 
-	log.Printf("Handler error for message %s: %v - moving to DLQ", entry.ID, err)
+	if attempts <= MaxRetries {
+		log.Printf("Handler error for message %s (attempt %d/%d): %v - retrying in %s",
+			entry.ID, attempts, MaxRetries+1, err, retryBackoff(attempts))
+		return
+	}
+
+	log.Printf("Handler error for message %s (attempt %d/%d): %v - moving to DLQ", entry.ID, attempts, MaxRetries+1, err)
 
-	r.moveToDLQ(ctx, topic, entry)
-	r.client.XAck(ctx, topic, r.group, entry.ID)
+	// DLQ'ya yazılamazsa mesaj pending kalır ve bir sonraki turda tekrar denenir.
+	if r.moveToDLQ(ctx, topic, entry, attempts, err) {
+		r.client.XAck(ctx, topic, r.group, entry.ID)
+	}
 }
 
-func (r *redisBus) moveToDLQ(ctx context.Context, topic string, entry redis.XMessage) {
+func (r *redisBus) moveToDLQ(ctx context.Context, topic string, entry redis.XMessage, attempts int64, handlerErr error) bool {
 	dlqTopic := topic + DLQSuffix
 
+	values := make(map[string]any, len(entry.Values)+4)
+	for k, v := range entry.Values {
+		values[k] = v
+	}
+	values["error"] = handlerErr.Error()
+	values["attempts"] = attempts
+	values["original_id"] = entry.ID
+	values["failed_at"] = time.Now().UTC().Format(time.RFC3339)
+
 	err := r.client.XAdd(ctx, &redis.XAddArgs{
 		Stream: dlqTopic,
-		Values: entry.Values,
+		Values: values,
 	}).Err()
 
 	if err != nil {
 		log.Printf("Failed to move message %s to DLQ: %v", entry.ID, err)
-	} else {
-		log.Printf("⚠️  Moved message %s to DLQ: %s", entry.ID, dlqTopic)
+		return false
 	}
+	log.Printf("⚠️  Moved message %s to DLQ: %s", entry.ID, dlqTopic)
+	return true
 }
 
-func (r *redisBus) processPendingMessages(ctx context.Context, topic string, handler func([]byte) error) {
-	log.Printf("Processing pending messages for topic: %s", topic)
+// retryBackoff attempts teslimattan sonra bir sonraki denemeye kadar beklenecek süredir:
+// RetryBaseDelay, 2x, 4x... DLQ'ya yazılamayan mesajlar son beklemeyle denenmeye devam eder.
+func retryBackoff(attempts int64) time.Duration {
+	if attempts > MaxRetries {
+		attempts = MaxRetries
+	}
+	return RetryBaseDelay << (attempts - 1)
+}
 
-	start := "0"
+// retryPendingMessages grubun onaylanmamış mesajlarını (bu veya çökmüş başka bir worker'ın)
+// tarar ve bekleme süresi dolanları XCLAIM ile üstlenip tekrar işler. Teslimat sayısı
+// Redis'te tutulur; XAUTOCLAIM tek bir bekleme süresi aldığı ve teslimat sayısını
+// döndürmediği için mesaj başına üstel bekleme XPENDING ile hesaplanır.
+func (r *redisBus) retryPendingMessages(ctx context.Context, topic string, handler func([]byte) error) {
+	start := "-"
 	for {
-		pending, err := r.client.XReadGroup(ctx, &redis.XReadGroupArgs{
-			Group:    r.group,
-			Consumer: r.worker,
-			Streams:  []string{topic, start},
-			Count:    BatchSize,
+		pending, err := r.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+			Stream: topic,
+			Group:  r.group,
+			Idle:   RetryBaseDelay,
+			Start:  start,
+			End:    "+",
+			Count:  BatchSize,
 		}).Result()
 
 		if err != nil && err != redis.Nil {
-			log.Printf("Failed to read pending messages: %v", err)
+			if err != context.Canceled {
+				log.Printf("Failed to read pending messages for topic %s: %v", topic, err)
+			}
 			return
 		}
 
-		if len(pending) == 0 || len(pending[0].Messages) == 0 {
-			break
-		}
+		for _, p := range pending {
+			backoff := retryBackoff(p.RetryCount)
+			if p.Idle < backoff {
+				continue
+			}
 
-		for _, entry := range pending[0].Messages {
-			r.handleMessage(ctx, topic, entry, handler)
-			start = entry.ID
+			// MinIdle sayesinde aynı mesajı aynı anda sadece bir worker üstlenir.
+			claimed, err := r.client.XClaim(ctx, &redis.XClaimArgs{
+				Stream:   topic,
+				Group:    r.group,
+				Consumer: r.worker,
+				MinIdle:  backoff,
+				Messages: []string{p.ID},
+			}).Result()
+			if err != nil {
+				log.Printf("Failed to claim message %s: %v", p.ID, err)
+				continue
+			}
+
+			for _, entry := range claimed {
+				r.handleMessage(ctx, topic, entry, p.RetryCount+1, handler)
+			}
 		}
 
-		if len(pending[0].Messages) < BatchSize {
-			break
+		if len(pending) < BatchSize {
+			return
 		}
+		start = "(" + pending[len(pending)-1].ID
 	}
-
-	log.Printf("✓ Pending messages processed for topic: %s", topic)
 }
 
 func (r *redisBus) Close() error {
@@ -21,9 +21,18 @@ var (
 		},
 		[]string{"method", "endpoint"},
 	)
+
+	DLQDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "eventbus_dlq_depth",
+			Help: "Number of messages waiting in the dead-letter stream of each topic",
+		},
+		[]string{"topic"},
+	)
 )
 
 func Init() {
 	prometheus.MustRegister(HttpRequestsTotal)
 	prometheus.MustRegister(HttpRequestDuration)
+	prometheus.MustRegister(DLQDepth)
 }
@@ -2,78 +2,129 @@ package middleware
 
 import (
 	"context"
-	"log"
 	"net/http"
-	"os"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/token"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
-var (
-	jwtKey  []byte
-	jwtOnce sync.Once
-)
+type TokenRevocationChecker interface {
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
 
-func getJWTKey() []byte {
-	jwtOnce.Do(func() {
-		key := os.Getenv("JWT_SECRET")
-		if key == "" {
-			log.Fatal("JWT_SECRET environment variable is not set")
-		}
-		jwtKey = []byte(key)
-	})
-	return jwtKey
+// UserStatusChecker token'ın sahibi askıya alınmış veya silinmişse false döner.
+type UserStatusChecker interface {
+	IsUserActive(ctx context.Context, userID string) (bool, error)
 }
 
-type Claims struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	Role     string `json:"role"`
-	jwt.RegisteredClaims
+// PATIdentity kişisel erişim token'ının sahibini ve token'a verilen yetkileri taşır.
+type PATIdentity struct {
+	UserID      string
+	Username    string
+	Role        string
+	Permissions []string
 }
 
-func AuthMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == "/auth/login" || r.URL.Path == "/health" {
-			next.ServeHTTP(w, r)
-			return
-		}
-
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" {
-			resp := utils.ErrorResponse("UNAUTHORIZED", "Giriş yapmanız gerekiyor", "Token eksik")
-			utils.Return(w, http.StatusUnauthorized, resp)
-			return
-		}
-
-		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			resp := utils.ErrorResponse("UNAUTHORIZED", "Geçersiz token formatı", "Bearer token bekleniyor")
-			utils.Return(w, http.StatusUnauthorized, resp)
-			return
-		}
-		tokenString := parts[1]
-
-		claims := &Claims{}
-		token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-			return getJWTKey(), nil
-		})
+type PATAuthenticator interface {
+	// AuthenticatePAT geçersiz, süresi dolmuş veya bilinmeyen token'lar için nil döner.
+	AuthenticatePAT(ctx context.Context, raw string) (*PATIdentity, error)
+}
 
-		if err != nil || !token.Valid {
-			resp := utils.ErrorResponse("UNAUTHORIZED", "Oturum süresi dolmuş", "Token geçersiz veya süresi dolmuş")
-			utils.Return(w, http.StatusUnauthorized, resp)
-			return
-		}
+// AuthMiddleware token'ı doğrular ve jti iptal listesindeyse veya kullanıcı aktif değilse
+// isteği reddeder. "pat_" ile başlayan token'lar kişisel erişim token'ı olarak doğrulanır.
+func AuthMiddleware(tokens *token.Manager, revocations TokenRevocationChecker, pats PATAuthenticator, users UserStatusChecker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/auth/login" || r.URL.Path == "/health" {
+				next.ServeHTTP(w, r)
+				return
+			}
 
-		ctx := context.WithValue(r.Context(), utils.RoleKey, claims.Role)
-		ctx = context.WithValue(ctx, utils.UsernameKey, claims.Username)
-		ctx = context.WithValue(ctx, utils.UserIDKey, claims.UserID)
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+			authHeader := r.Header.Get("Authorization")
+			if authHeader == "" {
+				resp := utils.ErrorResponse("UNAUTHORIZED", "Giriş yapmanız gerekiyor", "Token eksik")
+				utils.Return(w, http.StatusUnauthorized, resp)
+				return
+			}
+
+			parts := strings.Split(authHeader, " ")
+			if len(parts) != 2 || parts[0] != "Bearer" {
+				resp := utils.ErrorResponse("UNAUTHORIZED", "Geçersiz token formatı", "Bearer token bekleniyor")
+				utils.Return(w, http.StatusUnauthorized, resp)
+				return
+			}
+			tokenString := parts[1]
+
+			if strings.HasPrefix(tokenString, token.PATPrefix) {
+				identity, err := pats.AuthenticatePAT(r.Context(), tokenString)
+				if err != nil {
+					resp := utils.ErrorResponse("INTERNAL_ERROR", "Token doğrulanamadı", err.Error())
+					utils.Return(w, http.StatusInternalServerError, resp)
+					return
+				}
+				if identity == nil {
+					resp := utils.ErrorResponse("UNAUTHORIZED", "Token geçersiz", "Token geçersiz, iptal edilmiş veya süresi dolmuş")
+					utils.Return(w, http.StatusUnauthorized, resp)
+					return
+				}
+
+				ctx := context.WithValue(r.Context(), utils.RoleKey, identity.Role)
+				ctx = context.WithValue(ctx, utils.UsernameKey, identity.Username)
+				ctx = context.WithValue(ctx, utils.UserIDKey, identity.UserID)
+				ctx = context.WithValue(ctx, utils.TokenScopesKey, identity.Permissions)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			claims, err := tokens.Parse(tokenString)
+			if err == nil {
+				if userID, parseErr := uuid.Parse(claims.UserID); parseErr != nil || userID == uuid.Nil || claims.ID == "" || claims.Purpose != "" {
+					err = jwt.ErrTokenInvalidClaims
+				}
+			}
+
+			if err != nil {
+				resp := utils.ErrorResponse("UNAUTHORIZED", "Oturum süresi dolmuş", "Token geçersiz veya süresi dolmuş")
+				utils.Return(w, http.StatusUnauthorized, resp)
+				return
+			}
+
+			revoked, err := revocations.IsRevoked(r.Context(), claims.ID)
+			if err != nil {
+				resp := utils.ErrorResponse("INTERNAL_ERROR", "Token doğrulanamadı", err.Error())
+				utils.Return(w, http.StatusInternalServerError, resp)
+				return
+			}
+			if revoked {
+				resp := utils.ErrorResponse("UNAUTHORIZED", "Oturum sonlandırılmış", "Token iptal edilmiş")
+				utils.Return(w, http.StatusUnauthorized, resp)
+				return
+			}
+
+			active, err := users.IsUserActive(r.Context(), claims.UserID)
+			if err != nil {
+				resp := utils.ErrorResponse("INTERNAL_ERROR", "Token doğrulanamadı", err.Error())
+				utils.Return(w, http.StatusInternalServerError, resp)
+				return
+			}
+			if !active {
+				resp := utils.ErrorResponse("ACCOUNT_DISABLED", "Hesabınız devre dışı bırakılmış", "Kullanıcı askıya alınmış veya silinmiş")
+				utils.Return(w, http.StatusUnauthorized, resp)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), utils.RoleKey, claims.Role)
+			ctx = context.WithValue(ctx, utils.UsernameKey, claims.Username)
+			ctx = context.WithValue(ctx, utils.UserIDKey, claims.UserID)
+			ctx = context.WithValue(ctx, utils.TokenIDKey, claims.ID)
+			ctx = context.WithValue(ctx, utils.TokenExpiresAtKey, claims.ExpiresAt.Time)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
 }
 
 func TimeoutMiddleware(next http.Handler) http.Handler {
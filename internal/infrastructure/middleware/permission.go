@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+)
+
+type PermissionChecker interface {
+	HasPermission(ctx context.Context, role string, permission string) (bool, error)
+}
+
+type Authorizer struct {
+	checker PermissionChecker
+}
+
+func NewAuthorizer(checker PermissionChecker) *Authorizer {
+	return &Authorizer{checker: checker}
+}
+
+// RequirePermission handler'ı yalnızca AuthMiddleware'in belirlediği rol verilen
+// izne sahipse çalıştırır. Personal access token ile gelen isteklerde izin ayrıca
+// token'ın scope'larında da bulunmalıdır.
+func (a *Authorizer) RequirePermission(permission string) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			role := utils.GetRoleFromContext(r.Context())
+
+			allowed, err := a.checker.HasPermission(r.Context(), role, permission)
+			if err != nil {
+				utils.ReturnError(w, "INTERNAL_ERROR", "Yetki kontrolü yapılamadı", err.Error())
+				return
+			}
+			if !allowed {
+				utils.ReturnError(w, "FORBIDDEN", "Bu işlem için yetkiniz yok", "Gerekli yetki: "+permission)
+				return
+			}
+			if scopes, isPAT := utils.GetTokenScopesFromContext(r.Context()); isPAT && !slices.Contains(scopes, permission) {
+				utils.ReturnError(w, "FORBIDDEN", "Token bu işlem için yetkili değil", "Gerekli yetki: "+permission)
+				return
+			}
+
+			next(w, r)
+		}
+	}
+}
+
+// RequireSession personal access token ile doğrulanmış istekleri reddeder.
+// Hesap route'ları (şifre, 2FA, token yönetimi) etkileşimli giriş ister; böylece
+// sızan bir token ile hesap ele geçirilemez veya daha geniş token üretilemez.
+func RequireSession(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if _, isPAT := utils.GetTokenScopesFromContext(r.Context()); isPAT {
+			utils.ReturnError(w, "FORBIDDEN", "Bu işlem için giriş yapmanız gerekiyor", "Kişisel erişim token'ı ile kullanılamaz")
+			return
+		}
+		next(w, r)
+	}
+}
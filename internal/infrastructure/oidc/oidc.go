@@ -0,0 +1,286 @@
+// Package oidc OpenID Connect authorization code + PKCE akışının istemci tarafıdır:
+// discovery, yetkilendirme adresi, kod değişimi ve id_token doğrulaması. HTTP istemcisi ve
+// saat dışarıdan verilebildiği için httptest ile kurulan sahte bir sağlayıcıya karşı
+// çevrimdışı test edilebilir.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultHTTPTimeout = 10 * time.Second
+	// jwksMinRefresh bilinmeyen kid geldiğinde anahtarların en sık yenilenme aralığıdır.
+	jwksMinRefresh  = time.Minute
+	maxResponseSize = 1 << 20
+)
+
+type Config struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+
+	HTTPClient *http.Client
+	Now        func() time.Time
+}
+
+// Provider discovery belgesini ve imza anahtarlarını ilk kullanımda çekip önbellekler.
+type Provider struct {
+	cfg Config
+
+	mu            sync.Mutex
+	metadata      *metadata
+	keys          map[string]interface{}
+	keysFetchedAt time.Time
+}
+
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// StatusError sağlayıcının 200 dışı yanıtıdır. Token endpoint'i geçersiz veya kullanılmış
+// kodlar için 4xx (invalid_grant) döner.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, e.Body)
+}
+
+type Tokens struct {
+	AccessToken string `json:"access_token"`
+	IDToken     string `json:"id_token"`
+	TokenType   string `json:"token_type"`
+}
+
+func NewProvider(cfg Config) *Provider {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: defaultHTTPTimeout}
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "profile", "email"}
+	}
+	cfg.IssuerURL = strings.TrimSuffix(cfg.IssuerURL, "/")
+	return &Provider{cfg: cfg}
+}
+
+// NewPKCE RFC 7636 S256 verifier/challenge çifti üretir.
+func NewPKCE() (verifier, challenge string, err error) {
+	verifier, err = RandomString(32)
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// RandomString state, nonce ve verifier için URL güvenli rastgele değer üretir.
+func RandomString(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// AuthCodeURL kullanıcının yönlendirileceği yetkilendirme adresidir.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", p.cfg.ClientID)
+	params.Set("redirect_uri", p.cfg.RedirectURL)
+	params.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", codeChallenge)
+	params.Set("code_challenge_method", "S256")
+
+	sep := "?"
+	if strings.Contains(md.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return md.AuthorizationEndpoint + sep + params.Encode(), nil
+}
+
+// Exchange authorization code'u token'lara çevirir.
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier string) (*Tokens, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+	form.Set("client_id", p.cfg.ClientID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	tokens := &Tokens{}
+	if err := p.do(req, tokens); err != nil {
+		return nil, fmt.Errorf("oidc: token exchange failed: %w", err)
+	}
+	if tokens.IDToken == "" {
+		return nil, errors.New("oidc: token response has no id_token")
+	}
+	return tokens, nil
+}
+
+// VerifyIDToken imzayı, issuer'ı, audience'ı, süreyi ve nonce'u doğrulayıp claim'leri döner.
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (jwt.MapClaims, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.key(ctx, md, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(md.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(p.cfg.Now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: invalid id_token: %w", err)
+	}
+
+	if got, _ := claims["nonce"].(string); got != nonce {
+		return nil, errors.New("oidc: id_token nonce mismatch")
+	}
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return nil, errors.New("oidc: id_token has no subject")
+	}
+	return claims, nil
+}
+
+func (p *Provider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.cfg.IssuerURL+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	md := &metadata{}
+	if err := p.do(req, md); err != nil {
+		return nil, fmt.Errorf("oidc: discovery failed: %w", err)
+	}
+	if strings.TrimSuffix(md.Issuer, "/") != p.cfg.IssuerURL {
+		return nil, fmt.Errorf("oidc: issuer mismatch, expected %q got %q", p.cfg.IssuerURL, md.Issuer)
+	}
+	if md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" || md.JWKSURI == "" {
+		return nil, errors.New("oidc: discovery document is missing endpoints")
+	}
+
+	p.metadata = md
+	return md, nil
+}
+
+// key kid'e karşılık gelen public key'i döner; bilinmeyen kid sağlayıcının anahtar
+// rotasyonu olabileceğinden JWKS en fazla jwksMinRefresh'te bir yeniden çekilir.
+func (p *Provider) key(ctx context.Context, md *metadata, kid string) (interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key := p.lookupKey(kid); key != nil {
+		return key, nil
+	}
+	if p.keys != nil && p.cfg.Now().Sub(p.keysFetchedAt) < jwksMinRefresh {
+		return nil, fmt.Errorf("oidc: unknown key id %q", kid)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, md.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	var set jwkSet
+	if err := p.do(req, &set); err != nil {
+		return nil, fmt.Errorf("oidc: failed to fetch jwks: %w", err)
+	}
+
+	keys, err := set.publicKeys()
+	if err != nil {
+		return nil, err
+	}
+	p.keys = keys
+	p.keysFetchedAt = p.cfg.Now()
+
+	if key := p.lookupKey(kid); key != nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("oidc: unknown key id %q", kid)
+}
+
+// lookupKey kid boşsa ve tek anahtar varsa onu döner.
+func (p *Provider) lookupKey(kid string) interface{} {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key
+		}
+	}
+	return p.keys[kid]
+}
+
+func (p *Provider) do(req *http.Request, out interface{}) error {
+	resp, err := p.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &StatusError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(body))}
+	}
+	return json.Unmarshal(body, out)
+}
@@ -0,0 +1,331 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testClientID = "task-app"
+
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// fakeIssuer discovery, JWKS ve token endpoint'lerini sunan sahte bir OIDC sağlayıcısıdır.
+type fakeIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+
+	mu         sync.Mutex
+	jwksCalls  int
+	issuer     string // boş değilse discovery'de dönen issuer
+	idToken    string
+	tokenForms []url.Values
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fakeIssuer{key: key, kid: "key-1"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		issuer := f.issuer
+		f.mu.Unlock()
+		if issuer == "" {
+			issuer = f.server.URL
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer,
+			"authorization_endpoint": f.server.URL + "/authorize",
+			"token_endpoint":         f.server.URL + "/token",
+			"jwks_uri":               f.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		f.jwksCalls++
+		kid := f.kid
+		f.mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		f.mu.Lock()
+		f.tokenForms = append(f.tokenForms, r.PostForm)
+		idToken := f.idToken
+		f.mu.Unlock()
+		if r.PostForm.Get("code") != "good-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "access",
+			"id_token":     idToken,
+			"token_type":   "Bearer",
+		})
+	})
+
+	f.server = httptest.NewServer(mux)
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeIssuer) provider(now func() time.Time) *Provider {
+	return NewProvider(Config{
+		IssuerURL:   f.server.URL,
+		ClientID:    testClientID,
+		RedirectURL: "https://app.example.com/callback",
+		HTTPClient:  f.server.Client(),
+		Now:         now,
+	})
+}
+
+// claims geçerli bir id_token'ın claim'leridir; testler tek tek bozar.
+func (f *fakeIssuer) claims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":   f.server.URL,
+		"sub":   "user-123",
+		"aud":   testClientID,
+		"nonce": "nonce-1",
+		"iat":   testNow.Add(-time.Minute).Unix(),
+		"exp":   testNow.Add(time.Hour).Unix(),
+		"email": "ali@example.com",
+	}
+}
+
+func (f *fakeIssuer) sign(t *testing.T, claims jwt.MapClaims, kid string) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(f.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func fixedClock(t time.Time) func() time.Time {
+	return func() time.Time { return t }
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	f := newFakeIssuer(t)
+
+	tests := []struct {
+		name    string
+		mutate  func(jwt.MapClaims)
+		kid     string
+		nonce   string
+		now     time.Time
+		wantErr string
+	}{
+		{name: "geçerli token", kid: "key-1", nonce: "nonce-1", now: testNow},
+		{name: "kid olmadan tek anahtar", kid: "", nonce: "nonce-1", now: testNow},
+		{
+			name:    "yanlış issuer",
+			mutate:  func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" },
+			kid:     "key-1",
+			nonce:   "nonce-1",
+			now:     testNow,
+			wantErr: "issuer",
+		},
+		{
+			name:    "yanlış audience",
+			mutate:  func(c jwt.MapClaims) { c["aud"] = "other-app" },
+			kid:     "key-1",
+			nonce:   "nonce-1",
+			now:     testNow,
+			wantErr: "audience",
+		},
+		{name: "yanlış nonce", kid: "key-1", nonce: "nonce-2", now: testNow, wantErr: "nonce"},
+		{name: "süresi dolmuş", kid: "key-1", nonce: "nonce-1", now: testNow.Add(2 * time.Hour), wantErr: "expired"},
+		{
+			name:    "exp yok",
+			mutate:  func(c jwt.MapClaims) { delete(c, "exp") },
+			kid:     "key-1",
+			nonce:   "nonce-1",
+			now:     testNow,
+			wantErr: "exp",
+		},
+		{
+			name:    "subject yok",
+			mutate:  func(c jwt.MapClaims) { delete(c, "sub") },
+			kid:     "key-1",
+			nonce:   "nonce-1",
+			now:     testNow,
+			wantErr: "subject",
+		},
+		{name: "bilinmeyen kid", kid: "key-9", nonce: "nonce-1", now: testNow, wantErr: "unknown key id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := f.claims()
+			if tt.mutate != nil {
+				tt.mutate(claims)
+			}
+			raw := f.sign(t, claims, tt.kid)
+
+			got, err := f.provider(fixedClock(tt.now)).VerifyIDToken(context.Background(), raw, tt.nonce)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("VerifyIDToken() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyIDToken() error = %v", err)
+			}
+			if got["email"] != "ali@example.com" {
+				t.Errorf("claims = %v", got)
+			}
+		})
+	}
+}
+
+func TestVerifyIDTokenRejectsForeignSignature(t *testing.T) {
+	f := newFakeIssuer(t)
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, f.claims())
+	token.Header["kid"] = "key-1"
+	raw, _ := token.SignedString(other)
+
+	if _, err := f.provider(fixedClock(testNow)).VerifyIDToken(context.Background(), raw, "nonce-1"); err == nil {
+		t.Fatal("VerifyIDToken() accepted a token signed with another key")
+	}
+}
+
+func TestVerifyIDTokenRejectsHMAC(t *testing.T) {
+	f := newFakeIssuer(t)
+	raw, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, f.claims()).SignedString([]byte("secret"))
+
+	if _, err := f.provider(fixedClock(testNow)).VerifyIDToken(context.Background(), raw, "nonce-1"); err == nil {
+		t.Fatal("VerifyIDToken() accepted an HS256 token")
+	}
+}
+
+// Bilinmeyen kid anahtar rotasyonu olabilir; JWKS yeniden çekilir ama en fazla
+// jwksMinRefresh'te bir.
+func TestUnknownKidRefreshesJWKS(t *testing.T) {
+	f := newFakeIssuer(t)
+	now := testNow
+	p := f.provider(func() time.Time { return now })
+	ctx := context.Background()
+
+	if _, err := p.VerifyIDToken(ctx, f.sign(t, f.claims(), "key-1"), "nonce-1"); err != nil {
+		t.Fatalf("VerifyIDToken() error = %v", err)
+	}
+
+	// Sağlayıcı anahtarı yeni kid ile yayınlar.
+	f.mu.Lock()
+	f.kid = "key-2"
+	f.mu.Unlock()
+	rotated := f.sign(t, f.claims(), "key-2")
+
+	if _, err := p.VerifyIDToken(ctx, rotated, "nonce-1"); err == nil || !strings.Contains(err.Error(), "unknown key id") {
+		t.Fatalf("VerifyIDToken() before refresh interval error = %v, want unknown key id", err)
+	}
+
+	now = now.Add(jwksMinRefresh)
+	if _, err := p.VerifyIDToken(ctx, rotated, "nonce-1"); err != nil {
+		t.Fatalf("VerifyIDToken() after refresh interval error = %v", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.jwksCalls != 2 {
+		t.Errorf("jwks fetched %d times, want 2", f.jwksCalls)
+	}
+}
+
+func TestDiscoveryIssuerMismatch(t *testing.T) {
+	f := newFakeIssuer(t)
+	f.issuer = "https://evil.example.com"
+
+	_, err := f.provider(fixedClock(testNow)).AuthCodeURL(context.Background(), "state", "nonce", "challenge")
+	if err == nil || !strings.Contains(err.Error(), "issuer mismatch") {
+		t.Fatalf("AuthCodeURL() error = %v, want issuer mismatch", err)
+	}
+}
+
+func TestAuthCodeURLAndExchange(t *testing.T) {
+	f := newFakeIssuer(t)
+	p := f.provider(fixedClock(testNow))
+	ctx := context.Background()
+
+	verifier, challenge, err := NewPKCE()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	if challenge != base64.RawURLEncoding.EncodeToString(sum[:]) {
+		t.Fatal("PKCE challenge is not S256 of verifier")
+	}
+
+	authURL, err := p.AuthCodeURL(ctx, "state-1", "nonce-1", challenge)
+	if err != nil {
+		t.Fatalf("AuthCodeURL() error = %v", err)
+	}
+	u, _ := url.Parse(authURL)
+	q := u.Query()
+	for key, want := range map[string]string{
+		"response_type":         "code",
+		"client_id":             testClientID,
+		"state":                 "state-1",
+		"nonce":                 "nonce-1",
+		"code_challenge":        challenge,
+		"code_challenge_method": "S256",
+	} {
+		if got := q.Get(key); got != want {
+			t.Errorf("auth url %s = %q, want %q", key, got, want)
+		}
+	}
+
+	f.idToken = f.sign(t, f.claims(), "key-1")
+	tokens, err := p.Exchange(ctx, "good-code", verifier)
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	if tokens.IDToken != f.idToken {
+		t.Error("Exchange() returned a different id_token")
+	}
+	if got := f.tokenForms[0].Get("code_verifier"); got != verifier {
+		t.Errorf("token request code_verifier = %q", got)
+	}
+
+	_, err = p.Exchange(ctx, "used-code", verifier)
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("Exchange() with invalid code error = %v, want 400 StatusError", err)
+	}
+}
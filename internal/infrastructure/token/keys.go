@@ -0,0 +1,196 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+// Key bir imzalama/doğrulama anahtarıdır. HMAC anahtarlarında private ve public
+// aynı secret'tır; bu anahtarlar JWKS'de yayınlanmaz.
+type Key struct {
+	ID        string
+	Algorithm string
+
+	private any
+	public  any
+}
+
+func (k *Key) CanSign() bool {
+	return k.private != nil
+}
+
+func NewHMACKey(secret []byte, kid string) *Key {
+	return &Key{ID: kid, Algorithm: AlgHS256, private: secret, public: secret}
+}
+
+// LoadPrivateKeyPEM PKCS#1/PKCS#8 RSA veya PKCS#8 Ed25519 private key okur.
+// kid boşsa public key'in RFC 7638 thumbprint'i kullanılır.
+func LoadPrivateKeyPEM(path, kid string) (*Key, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var private any
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%s: unsupported PEM block %q", path, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var public any
+	switch k := private.(type) {
+	case *rsa.PrivateKey:
+		public = &k.PublicKey
+	case ed25519.PrivateKey:
+		public = k.Public()
+	default:
+		return nil, fmt.Errorf("%s: only RSA and Ed25519 keys are supported", path)
+	}
+
+	key, err := newPublicKey(public, kid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	key.private = private
+	return key, nil
+}
+
+// LoadPublicKeyPEM sadece doğrulama için PKIX public key veya sertifika okur.
+func LoadPublicKeyPEM(path, kid string) (*Key, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var public any
+	switch block.Type {
+	case "PUBLIC KEY":
+		public, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		public, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var cert *x509.Certificate
+		cert, err = x509.ParseCertificate(block.Bytes)
+		if err == nil {
+			public = cert.PublicKey
+		}
+	default:
+		return nil, fmt.Errorf("%s: unsupported PEM block %q", path, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	key, err := newPublicKey(public, kid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return key, nil
+}
+
+func newPublicKey(public any, kid string) (*Key, error) {
+	key := &Key{ID: kid, public: public}
+	switch public.(type) {
+	case *rsa.PublicKey:
+		key.Algorithm = AlgRS256
+	case ed25519.PublicKey:
+		key.Algorithm = AlgEdDSA
+	default:
+		return nil, errors.New("only RSA and Ed25519 keys are supported")
+	}
+
+	if key.ID == "" {
+		key.ID = key.thumbprint()
+	}
+	return key, nil
+}
+
+// jwk anahtarın public JWK gösterimidir; HMAC anahtarlarında nil döner.
+func (k *Key) jwk() *JWK {
+	switch public := k.public.(type) {
+	case *rsa.PublicKey:
+		return &JWK{
+			Kty: "RSA",
+			Kid: k.ID,
+			Use: "sig",
+			Alg: k.Algorithm,
+			N:   b64(public.N.Bytes()),
+			E:   b64(big.NewInt(int64(public.E)).Bytes()),
+		}
+	case ed25519.PublicKey:
+		return &JWK{
+			Kty: "OKP",
+			Kid: k.ID,
+			Use: "sig",
+			Alg: k.Algorithm,
+			Crv: "Ed25519",
+			X:   b64(public),
+		}
+	}
+	return nil
+}
+
+// thumbprint RFC 7638'e göre zorunlu JWK alanlarının sıralı JSON'unun SHA-256 özetidir.
+func (k *Key) thumbprint() string {
+	jwk := k.jwk()
+	if jwk == nil {
+		return ""
+	}
+
+	var members any
+	if jwk.Kty == "RSA" {
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	} else {
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Crv, jwk.Kty, jwk.X}
+	}
+
+	data, _ := json.Marshal(members)
+	sum := sha256.Sum256(data)
+	return b64(sum[:])
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM data found", path)
+	}
+	return block, nil
+}
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
@@ -0,0 +1,170 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// PurposeMFAPending şifresi doğrulanmış ama ikinci adımı tamamlanmamış girişlerin
+// token'ıdır; sadece /auth/mfa/verify tarafından kabul edilir.
+const PurposeMFAPending = "mfa_pending"
+
+// PATPrefix kişisel erişim token'larını JWT'lerden ayırır.
+const PATPrefix = "pat_"
+
+// Claims access token'larında taşınan claim'lerdir. jti (ID) iptal listesi için zorunludur.
+// Purpose boş değilse token API erişimi için kullanılamaz.
+type Claims struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	Purpose  string `json:"purpose,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Manager aktif anahtarla imzalar ve kid başlığına göre birden fazla anahtarla doğrular.
+// Rotasyon sırasında eski anahtar, üretilmiş token'ların süresi dolana kadar sadece
+// doğrulama anahtarı olarak tutulur.
+type Manager struct {
+	signing *Key
+	keys    map[string]*Key
+	methods []string
+	now     func() time.Time
+}
+
+func NewManager(signing *Key, verification ...*Key) (*Manager, error) {
+	if signing == nil || !signing.CanSign() {
+		return nil, errors.New("a signing key is required")
+	}
+
+	m := &Manager{
+		signing: signing,
+		keys:    make(map[string]*Key),
+		now:     time.Now,
+	}
+
+	seen := make(map[string]bool)
+	for _, key := range append([]*Key{signing}, verification...) {
+		if _, dup := m.keys[key.ID]; dup {
+			return nil, fmt.Errorf("duplicate key id %q", key.ID)
+		}
+		m.keys[key.ID] = key
+		if !seen[key.Algorithm] {
+			seen[key.Algorithm] = true
+			m.methods = append(m.methods, key.Algorithm)
+		}
+	}
+	return m, nil
+}
+
+// NewManagerFromEnv anahtarları ortam değişkenlerinden yükler:
+//
+//	JWT_SIGNING_KEY_FILE   RSA/Ed25519 private key (PEM); verilmezse JWT_SECRET ile HS256 kullanılır
+//	JWT_SIGNING_KEY_ID     imzalama anahtarının kid değeri (varsayılan: RFC 7638 thumbprint)
+//	JWT_VERIFICATION_KEYS  rotasyon için ek public key'ler: "kid=/yol/key.pem,kid2=/yol/key2.pem"
+//	JWT_SECRET             HS256 secret; asimetrik anahtarla birlikte verilirse sadece doğrulamada kullanılır
+func NewManagerFromEnv() (*Manager, error) {
+	var (
+		signing      *Key
+		verification []*Key
+		err          error
+	)
+
+	secret := os.Getenv("JWT_SECRET")
+	if path := os.Getenv("JWT_SIGNING_KEY_FILE"); path != "" {
+		signing, err = LoadPrivateKeyPEM(path, os.Getenv("JWT_SIGNING_KEY_ID"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load signing key: %w", err)
+		}
+		if secret != "" {
+			verification = append(verification, NewHMACKey([]byte(secret), "hs256"))
+		}
+	} else {
+		if secret == "" {
+			return nil, errors.New("either JWT_SIGNING_KEY_FILE or JWT_SECRET must be set")
+		}
+		kid := os.Getenv("JWT_SIGNING_KEY_ID")
+		if kid == "" {
+			kid = "hs256"
+		}
+		signing = NewHMACKey([]byte(secret), kid)
+	}
+
+	for _, entry := range strings.Split(os.Getenv("JWT_VERIFICATION_KEYS"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		kid, path, ok := strings.Cut(entry, "=")
+		if !ok {
+			kid, path = "", entry
+		}
+		key, err := LoadPublicKeyPEM(strings.TrimSpace(path), strings.TrimSpace(kid))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load verification key: %w", err)
+		}
+		verification = append(verification, key)
+	}
+
+	return NewManager(signing, verification...)
+}
+
+// WithClock exp/nbf doğrulamasında kullanılan saati değiştirir; testlerde sabit saat içindir.
+func (m *Manager) WithClock(now func() time.Time) *Manager {
+	m.now = now
+	return m
+}
+
+func (m *Manager) Sign(claims *Claims) (string, error) {
+	method := jwt.GetSigningMethod(m.signing.Algorithm)
+	if method == nil {
+		return "", fmt.Errorf("unsupported signing algorithm %q", m.signing.Algorithm)
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = m.signing.ID
+	return token.SignedString(m.signing.private)
+}
+
+// Parse imzayı kid'e karşılık gelen anahtarla doğrular. kid içermeyen eski token'lar
+// sadece aktif imzalama anahtarıyla doğrulanır.
+func (m *Manager) Parse(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		key := m.signing
+		if kid, ok := token.Header["kid"].(string); ok {
+			key, ok = m.keys[kid]
+			if !ok {
+				return nil, fmt.Errorf("unknown key id %q", kid)
+			}
+		}
+		if token.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("unexpected signing method %q for key %q", token.Method.Alg(), key.ID)
+		}
+		return key.public, nil
+	}, jwt.WithValidMethods(m.methods), jwt.WithExpirationRequired(), jwt.WithTimeFunc(m.now))
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// JWKS asimetrik doğrulama anahtarlarını döner; HMAC secret'ları asla yayınlanmaz.
+func (m *Manager) JWKS() JWKSet {
+	set := JWKSet{Keys: []JWK{}}
+	for _, key := range m.keys {
+		if jwk := key.jwk(); jwk != nil {
+			set.Keys = append(set.Keys, *jwk)
+		}
+	}
+	sort.Slice(set.Keys, func(i, j int) bool {
+		return set.Keys[i].Kid < set.Keys[j].Kid
+	})
+	return set
+}
@@ -1,25 +1,105 @@
 package domain
 
-import "github.com/golang-jwt/jwt/v5"
+import (
+	"time"
+
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
 
 type LoginRequest struct {
 	Username string `json:"username" validate:"required"`
 	Password string `json:"password" validate:"required"`
+	// Provider şifre tabanlı kimlik sağlayıcısıdır (local, ldap); boşsa varsayılan kullanılır.
+	Provider string `json:"provider" validate:"omitempty,max=20"`
+
+	// IP istemci adresidir; handler tarafından doldurulur.
+	IP string `json:"-"`
 }
 
 type LoginResponse struct {
-	Token    string `json:"token"`
-	Username string `json:"username"`
-	Role     string `json:"role"`
-	Ad       string `json:"ad"`
-	Soyad    string `json:"soyad"`
+	Token          string    `json:"token"`
+	TokenExpiresAt time.Time `json:"token_expires_at"`
+	RefreshToken   string    `json:"refresh_token"`
+	Username       string    `json:"username"`
+	Role           string    `json:"role"`
+	Ad             string    `json:"ad"`
+	Soyad          string    `json:"soyad"`
 }
 
-type Claims struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	Role     string `json:"role"`
-	jwt.RegisteredClaims
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+// LogoutRequest içindeki refresh token verilirse token ailesi de iptal edilir.
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RefreshToken her rotasyonda aynı FamilyID ile yeniden üretilir. Kullanılmış
+// (UsedAt dolu) bir token tekrar gelirse aile çalınmış sayılır ve tamamen iptal edilir.
+type RefreshToken struct {
+	ID        uuid.UUID  `db:"id"`
+	UserID    uuid.UUID  `db:"user_id"`
+	FamilyID  uuid.UUID  `db:"family_id"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+// Credentials sağlayıcıya göre doldurulur: local/ldap kullanıcı adı ve şifre, OIDC ise
+// authorization code ile login başlatılırken üretilen verifier ve nonce kullanır.
+type Credentials struct {
+	Username string
+	Password string
+
+	Code         string
+	CodeVerifier string
+	Nonce        string
+}
+
+// Identity bir sağlayıcının doğruladığı kullanıcıdır. Local sağlayıcı User'ı doğrudan
+// doldurur; dış sağlayıcılarda kullanıcı (Provider, Subject) ile eşlenir, yoksa ilk girişte
+// oluşturulur. Role, sağlayıcının grup/claim eşlemesinden gelen roldür.
+type Identity struct {
+	Provider string
+	Subject  string
+	Username string
+	Email    string
+	Ad       string
+	Soyad    string
+	Role     string
+	// RoleMapped Role bir eşleme kuralından geldiyse true'dur. Varsayılan rol mevcut
+	// kullanıcının admin tarafından değiştirilmiş rolünü ezmemelidir.
+	RoleMapped bool
+
+	User *userDomain.User
+}
+
+type OIDCStartResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"state"`
+}
+
+type OIDCCallbackRequest struct {
+	Code  string `json:"code" validate:"required"`
+	State string `json:"state" validate:"required"`
+
+	// IP istemci adresidir; handler tarafından doldurulur.
+	IP string `json:"-"`
+}
+
+// OIDCAuthRequest yetkilendirme adresine yönlendirme ile callback arasında PKCE verifier'ı
+// ve nonce'u saklar; state ile bir kez tüketilir.
+type OIDCAuthRequest struct {
+	State        string    `db:"state"`
+	Nonce        string    `db:"nonce"`
+	CodeVerifier string    `db:"code_verifier"`
+	ExpiresAt    time.Time `db:"expires_at"`
+	CreatedAt    time.Time `db:"created_at"`
 }
 
 type UserCredentials struct {
@@ -36,8 +116,262 @@ func (e ErrInvalidCredentials) Error() string {
 	return "invalid username or password"
 }
 
+// ErrAccountDisabled askıya alınmış bir hesapla giriş yapılmak istendiğinde döner.
+// Silinmiş hesaplar ErrInvalidCredentials ile yanıtlanır.
+type ErrAccountDisabled struct{}
+
+func (e ErrAccountDisabled) Error() string {
+	return "account is disabled"
+}
+
 type ErrTokenGeneration struct{}
 
 func (e ErrTokenGeneration) Error() string {
 	return "failed to generate token"
 }
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,password"`
+}
+
+type ForgotPasswordRequest struct {
+	Username string `json:"username" validate:"required"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required,password"`
+}
+
+// PasswordResetToken tek kullanımlıktır; yeni bir token istendiğinde kullanıcının
+// kullanılmamış eski token'ları geçersiz kılınır.
+type PasswordResetToken struct {
+	ID        uuid.UUID  `db:"id"`
+	UserID    uuid.UUID  `db:"user_id"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+// UserMFA kullanıcının TOTP kaydıdır. EnabledAt, kayıt ilk geçerli kodla doğrulanana
+// kadar boştur; bu süre içinde giriş tek adımlı kalır.
+type UserMFA struct {
+	UserID       uuid.UUID  `db:"user_id"`
+	Secret       string     `db:"secret"`
+	EnabledAt    *time.Time `db:"enabled_at"`
+	LastUsedStep *int64     `db:"last_used_step"`
+	CreatedAt    time.Time  `db:"created_at"`
+}
+
+func (m *UserMFA) Enabled() bool {
+	return m != nil && m.EnabledAt != nil
+}
+
+type MFAEnrollResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+type MFAActivateRequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+// MFAActivateResponse içindeki kurtarma kodları sadece bir kez, aktivasyon anında gösterilir.
+type MFAActivateResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type MFADisableRequest struct {
+	Password string `json:"password" validate:"required"`
+}
+
+// MFAChallenge 2FA açık kullanıcıların şifre doğrulamasından sonra aldığı yanıttır;
+// MFAToken sadece POST /auth/mfa/verify ile access token'a çevrilebilir.
+type MFAChallenge struct {
+	MFARequired       bool      `json:"mfa_required"`
+	MFAToken          string    `json:"mfa_token"`
+	MFATokenExpiresAt time.Time `json:"mfa_token_expires_at"`
+}
+
+// MFAVerifyRequest Code alanında authenticator kodu veya kurtarma kodu kabul eder.
+type MFAVerifyRequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required,max=32"`
+
+	// IP istemci adresidir; handler tarafından doldurulur.
+	IP string `json:"-"`
+}
+
+// PersonalAccessToken script ve CI erişimi için uzun ömürlü token'dır. Ham token sadece
+// oluşturulurken bir kez döner; Prefix listelemede token'ı tanımak içindir.
+type PersonalAccessToken struct {
+	ID          uuid.UUID      `json:"id" db:"id"`
+	UserID      uuid.UUID      `json:"-" db:"user_id"`
+	Name        string         `json:"name" db:"name"`
+	TokenHash   string         `json:"-" db:"token_hash"`
+	Prefix      string         `json:"prefix" db:"token_prefix"`
+	Permissions pq.StringArray `json:"permissions" db:"permissions"`
+	ExpiresAt   time.Time      `json:"expires_at" db:"expires_at"`
+	LastUsedAt  *time.Time     `json:"last_used_at" db:"last_used_at"`
+	CreatedAt   time.Time      `json:"created_at" db:"created_at"`
+}
+
+type CreatePATRequest struct {
+	Name        string   `json:"name" validate:"required,min=1,max=100"`
+	Permissions []string `json:"permissions" validate:"required,min=1,dive,required,max=50"`
+	// ExpiresInDays verilmezse token 90 gün geçerlidir.
+	ExpiresInDays int `json:"expires_in_days" validate:"omitempty,min=1,max=365"`
+}
+
+type CreatePATResponse struct {
+	Token string `json:"token"`
+	PersonalAccessToken
+}
+
+// LoginAttempt bir kullanıcı adı veya IP için art arda başarısız giriş sayacıdır.
+type LoginAttempt struct {
+	Key          string     `db:"key"`
+	Failures     int        `db:"failures"`
+	LastFailedAt time.Time  `db:"last_failed_at"`
+	LockedUntil  *time.Time `db:"locked_until"`
+}
+
+type ErrAccountLocked struct {
+	Until time.Time
+}
+
+func (e ErrAccountLocked) Error() string {
+	return "account is temporarily locked until " + e.Until.Format(time.RFC3339)
+}
+
+type ErrTooManyAttempts struct {
+	RetryAfter time.Duration
+}
+
+func (e ErrTooManyAttempts) Error() string {
+	return "too many failed login attempts, retry after " + e.RetryAfter.Round(time.Second).String()
+}
+
+type ErrInvalidRefreshToken struct{}
+
+func (e ErrInvalidRefreshToken) Error() string {
+	return "refresh token is invalid, expired or revoked"
+}
+
+type ErrRefreshTokenReused struct{}
+
+func (e ErrRefreshTokenReused) Error() string {
+	return "refresh token reuse detected, token family revoked"
+}
+
+type ErrWrongPassword struct{}
+
+func (e ErrWrongPassword) Error() string {
+	return "current password is incorrect"
+}
+
+type ErrPasswordUnchanged struct{}
+
+func (e ErrPasswordUnchanged) Error() string {
+	return "new password must differ from the current password"
+}
+
+type ErrInvalidResetToken struct{}
+
+func (e ErrInvalidResetToken) Error() string {
+	return "password reset token is invalid, expired or already used"
+}
+
+// ErrMFARequired Login'in şifre doğrulandıktan sonra ikinci adım istediğini bildirir.
+type ErrMFARequired struct {
+	Challenge MFAChallenge
+}
+
+func (e ErrMFARequired) Error() string {
+	return "two-factor authentication required"
+}
+
+type ErrInvalidMFACode struct{}
+
+func (e ErrInvalidMFACode) Error() string {
+	return "two-factor code is invalid or already used"
+}
+
+type ErrInvalidMFAToken struct{}
+
+func (e ErrInvalidMFAToken) Error() string {
+	return "mfa token is invalid, expired or already used"
+}
+
+type ErrMFAAlreadyEnabled struct{}
+
+func (e ErrMFAAlreadyEnabled) Error() string {
+	return "two-factor authentication is already enabled"
+}
+
+type ErrMFANotEnrolled struct{}
+
+func (e ErrMFANotEnrolled) Error() string {
+	return "two-factor authentication is not enrolled"
+}
+
+type ErrPATNotFound struct{}
+
+func (e ErrPATNotFound) Error() string {
+	return "personal access token not found"
+}
+
+type ErrPATNameTaken struct{}
+
+func (e ErrPATNameTaken) Error() string {
+	return "a personal access token with this name already exists"
+}
+
+// ErrPermissionNotGranted token'a kullanıcının rolünde olmayan bir yetki istendiğinde döner.
+type ErrPermissionNotGranted struct {
+	Permission string
+}
+
+func (e ErrPermissionNotGranted) Error() string {
+	return "role does not grant permission " + e.Permission
+}
+
+type ErrUnknownProvider struct {
+	Provider string
+}
+
+func (e ErrUnknownProvider) Error() string {
+	return "unknown or disabled identity provider " + e.Provider
+}
+
+// ErrIdentityConflict dış sağlayıcıdan gelen kullanıcı adı, bağlı olmayan bir yerel
+// hesaba aitse döner; hesaplar otomatik birleştirilmez.
+type ErrIdentityConflict struct {
+	Username string
+}
+
+func (e ErrIdentityConflict) Error() string {
+	return "username " + e.Username + " belongs to an account that is not linked to this provider"
+}
+
+type ErrInvalidOIDCState struct{}
+
+func (e ErrInvalidOIDCState) Error() string {
+	return "oidc state is invalid or expired"
+}
+
+// ErrProviderUnavailable dış sağlayıcıya ulaşılamadığında veya beklenmeyen yanıt verdiğinde döner.
+type ErrProviderUnavailable struct {
+	Provider string
+	Err      error
+}
+
+func (e ErrProviderUnavailable) Error() string {
+	return e.Provider + " provider unavailable: " + e.Err.Error()
+}
+
+func (e ErrProviderUnavailable) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, tx *sqlx.Tx, token *RefreshToken) error
+	// GetByHashForUpdate satırı kilitler; eşzamanlı iki rotasyondan sadece biri başarılı olur.
+	GetByHashForUpdate(ctx context.Context, tx *sqlx.Tx, tokenHash string) (*RefreshToken, error)
+	MarkUsed(ctx context.Context, tx *sqlx.Tx, id uuid.UUID) error
+	RevokeFamily(ctx context.Context, tx *sqlx.Tx, familyID uuid.UUID) error
+	RevokeByUser(ctx context.Context, userID uuid.UUID) error
+}
+
+type PasswordResetRepository interface {
+	Create(ctx context.Context, tx *sqlx.Tx, token *PasswordResetToken) error
+	InvalidateForUser(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID) error
+	GetByHashForUpdate(ctx context.Context, tx *sqlx.Tx, tokenHash string) (*PasswordResetToken, error)
+	MarkUsed(ctx context.Context, tx *sqlx.Tx, id uuid.UUID) error
+}
+
+type MFARepository interface {
+	Get(ctx context.Context, userID uuid.UUID) (*UserMFA, error)
+	// SavePending kaydı doğrulanmamış yeni bir secret ile oluşturur veya değiştirir.
+	SavePending(ctx context.Context, userID uuid.UUID, secret string) error
+	Enable(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID, step int64) error
+	// ConsumeStep son kullanılan adımı ilerletir; adım daha önce kullanılmışsa false döner.
+	ConsumeStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	Delete(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID) error
+	ReplaceRecoveryCodes(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID, codeHashes []string) error
+	// UseRecoveryCode kodu kullanılmış işaretler; kod yoksa veya kullanılmışsa false döner.
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
+}
+
+type PATRepository interface {
+	Create(ctx context.Context, token *PersonalAccessToken) error
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]PersonalAccessToken, error)
+	GetByHash(ctx context.Context, tokenHash string) (*PersonalAccessToken, error)
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+	// TouchLastUsed last_used_at'i günceller; her istekte yazmamak için dakikada bir yazar.
+	TouchLastUsed(ctx context.Context, id uuid.UUID) error
+}
+
+// PermissionChecker rbac modülünün rol-yetki kontrolüdür.
+type PermissionChecker interface {
+	HasPermission(ctx context.Context, role string, permission string) (bool, error)
+}
+
+// UserGuard yönetici işlemlerinin hedef kullanıcıya uygulanıp uygulanamayacağını söyler;
+// kullanıcı bulunamazsa ErrUserNotFound, rolü yönetilemiyorsa ErrManageForbidden döner.
+// user modülü tarafından sağlanır.
+type UserGuard interface {
+	CheckManageable(ctx context.Context, userID uuid.UUID) error
+}
+
+// Authenticator bir kimlik sağlayıcısıdır. Hatalı kimlik bilgileri ErrInvalidCredentials,
+// sağlayıcıya ulaşılamaması ErrProviderUnavailable olarak döner.
+type Authenticator interface {
+	Name() string
+	Authenticate(ctx context.Context, creds Credentials) (*Identity, error)
+}
+
+// RedirectAuthenticator tarayıcı yönlendirmesiyle çalışan (OIDC) sağlayıcılardır.
+type RedirectAuthenticator interface {
+	Authenticator
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+}
+
+// IdentityRepository dış sağlayıcı hesaplarını yerel kullanıcılara bağlar.
+type IdentityRepository interface {
+	// GetUserID bağlı kullanıcı yoksa nil döner.
+	GetUserID(ctx context.Context, provider, subject string) (*uuid.UUID, error)
+	Link(ctx context.Context, tx *sqlx.Tx, provider, subject string, userID uuid.UUID) error
+	TouchLogin(ctx context.Context, provider, subject string) error
+}
+
+type OIDCStateRepository interface {
+	Create(ctx context.Context, req *OIDCAuthRequest) error
+	// Consume kaydı silip döner; bulunamazsa nil döner.
+	Consume(ctx context.Context, state string) (*OIDCAuthRequest, error)
+}
+
+type RevokedTokenRepository interface {
+	Revoke(ctx context.Context, jti string, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// LoginAttemptStore başarısız giriş sayaçlarını tutar. Testler için bellek içi,
+// üretim için Postgres implementasyonu vardır.
+type LoginAttemptStore interface {
+	Get(ctx context.Context, key string) (*LoginAttempt, error)
+	// RecordFailure sayacı artırır; son hata window'dan eskiyse sayaç 1'den başlar.
+	RecordFailure(ctx context.Context, key string, window time.Duration) (*LoginAttempt, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Reset(ctx context.Context, key string) error
+	// Prune son hatası ve kilidi before'dan önce bitmiş sayaçları siler.
+	Prune(ctx context.Context, before time.Time) (int64, error)
+}
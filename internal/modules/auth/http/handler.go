@@ -2,13 +2,20 @@ package http
 
 import (
 	"encoding/json"
+	"math"
+	"net"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
 	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
 	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
 	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/service"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 )
 
 type AuthHandler struct {
@@ -37,17 +44,258 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	loginResp, err := h.service.Login(&req)
+	req.IP = clientIP(r)
+
+	loginResp, err := h.service.Login(r.Context(), &req)
 	if err != nil {
-		if _, ok := err.(domain.ErrInvalidCredentials); ok {
+		switch e := err.(type) {
+		case domain.ErrMFARequired:
+			utils.WriteJson(w, e.Challenge, http.StatusOK, "İki adımlı doğrulama gerekli")
+		case domain.ErrInvalidCredentials:
 			resp := utils.ErrorResponse("UNAUTHORIZED", "Hatalı kullanıcı adı veya şifre", err.Error())
 			utils.Return(w, http.StatusUnauthorized, resp)
+		default:
+			writeLoginError(w, err)
+		}
+		return
+	}
+
+	utils.WriteJson(w, loginResp, http.StatusOK, "Giriş başarılı")
+}
+
+func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	var req domain.RefreshRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	refreshResp, err := h.service.Refresh(r.Context(), &req)
+	if err != nil {
+		switch err.(type) {
+		case domain.ErrInvalidRefreshToken:
+			resp := utils.ErrorResponse("UNAUTHORIZED", "Oturum yenilenemedi, tekrar giriş yapın", err.Error())
+			utils.Return(w, http.StatusUnauthorized, resp)
+		case domain.ErrRefreshTokenReused:
+			resp := utils.ErrorResponse("TOKEN_REUSED", "Oturum güvenlik nedeniyle sonlandırıldı, tekrar giriş yapın", err.Error())
+			utils.Return(w, http.StatusUnauthorized, resp)
+		default:
+			resp := utils.ErrorResponse("INTERNAL_ERROR", "Sunucu hatası", err.Error())
+			utils.Return(w, http.StatusInternalServerError, resp)
+		}
+		return
+	}
+
+	utils.WriteJson(w, refreshResp, http.StatusOK, "Oturum yenilendi")
+}
+
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var req domain.LogoutRequest
+	if r.ContentLength != 0 && !h.decode(w, r, &req) {
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.service.Logout(ctx, utils.GetTokenIDFromContext(ctx), utils.GetTokenExpiresAtFromContext(ctx), &req); err != nil {
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Çıkış yapılamadı", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Çıkış yapıldı")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *AuthHandler) RevokeUserSessions(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz UUID formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.service.RevokeUserSessions(r.Context(), userID); err != nil {
+		switch err.(type) {
+		case userDomain.ErrUserNotFound:
+			resp := utils.ErrorResponse("NOT_FOUND", "Kullanıcı bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+		case userDomain.ErrManageForbidden:
+			resp := utils.ErrorResponse("FORBIDDEN", "Bu roldeki kullanıcıyı yönetmek için yetkiniz yok", "Gerekli yetki: role:manage")
+			utils.Return(w, http.StatusForbidden, resp)
+		default:
+			resp := utils.ErrorResponse("INTERNAL_ERROR", "Oturumlar sonlandırılamadı", err.Error())
+			utils.Return(w, http.StatusInternalServerError, resp)
+		}
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Kullanıcının tüm oturumları sonlandırıldı")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *AuthHandler) UnlockUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz UUID formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.service.UnlockUser(r.Context(), userID); err != nil {
+		if _, ok := err.(userDomain.ErrUserNotFound); ok {
+			resp := utils.ErrorResponse("NOT_FOUND", "Kullanıcı bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
 			return
 		}
-		resp := utils.ErrorResponse("INTERNAL_ERROR", "Sunucu hatası", err.Error())
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Hesap kilidi kaldırılamadı", err.Error())
 		utils.Return(w, http.StatusInternalServerError, resp)
 		return
 	}
 
-	utils.WriteJson(w, loginResp, http.StatusOK, "Giriş başarılı")
+	resp := utils.SuccessResponse(nil, "Hesap kilidi kaldırıldı")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var req domain.ChangePasswordRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.ChangePassword(r.Context(), userID, &req); err != nil {
+		switch err.(type) {
+		case domain.ErrWrongPassword:
+			resp := utils.ErrorResponse("VALIDATION_ERROR", "Mevcut şifre hatalı", err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+		case domain.ErrPasswordUnchanged:
+			resp := utils.ErrorResponse("VALIDATION_ERROR", "Yeni şifre mevcut şifreyle aynı olamaz", err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+		case userDomain.ErrUserNotFound:
+			resp := utils.ErrorResponse("NOT_FOUND", "Kullanıcı bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+		default:
+			resp := utils.ErrorResponse("INTERNAL_ERROR", "Şifre değiştirilemedi", err.Error())
+			utils.Return(w, http.StatusInternalServerError, resp)
+		}
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Şifre başarıyla değiştirildi")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *AuthHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req domain.ForgotPasswordRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := h.service.RequestPasswordReset(r.Context(), &req); err != nil {
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Şifre sıfırlama talebi oluşturulamadı", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Kullanıcı kayıtlıysa şifre sıfırlama bağlantısı email adresine gönderildi")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *AuthHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req domain.ResetPasswordRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := h.service.ResetPassword(r.Context(), &req); err != nil {
+		if _, ok := err.(domain.ErrInvalidResetToken); ok {
+			resp := utils.ErrorResponse("INVALID_TOKEN", "Sıfırlama bağlantısı geçersiz veya süresi dolmuş", err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+			return
+		}
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Şifre sıfırlanamadı", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Şifre başarıyla sıfırlandı, yeni şifrenizle giriş yapabilirsiniz")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+// JWKS diğer servislerin token'larımızı secret paylaşmadan doğrulayabilmesi için
+// public anahtarları RFC 7517 formatında, response zarfı olmadan döner.
+func (h *AuthHandler) JWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/jwk-set+json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	json.NewEncoder(w).Encode(h.service.JWKS())
+}
+
+func (h *AuthHandler) decode(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	return true
+}
+
+// writeLoginError kilit ve backoff hatalarını Retry-After başlığıyla, kimlik sağlayıcısı
+// hatalarını da ilgili durum koduyla yazar.
+func writeLoginError(w http.ResponseWriter, err error) {
+	switch e := err.(type) {
+	case domain.ErrAccountLocked:
+		w.Header().Set("Retry-After", retryAfterSeconds(time.Until(e.Until)))
+		resp := utils.ErrorResponse("ACCOUNT_LOCKED", "Çok sayıda hatalı giriş nedeniyle hesap geçici olarak kilitlendi", err.Error())
+		utils.Return(w, http.StatusLocked, resp)
+	case domain.ErrTooManyAttempts:
+		w.Header().Set("Retry-After", retryAfterSeconds(e.RetryAfter))
+		resp := utils.ErrorResponse("TOO_MANY_ATTEMPTS", "Çok fazla hatalı giriş denemesi, lütfen biraz bekleyin", err.Error())
+		utils.Return(w, http.StatusTooManyRequests, resp)
+	case domain.ErrInvalidCredentials:
+		resp := utils.ErrorResponse("UNAUTHORIZED", "Hatalı kullanıcı adı veya şifre", err.Error())
+		utils.Return(w, http.StatusUnauthorized, resp)
+	case domain.ErrAccountDisabled:
+		resp := utils.ErrorResponse("ACCOUNT_DISABLED", "Hesabınız devre dışı bırakılmış", err.Error())
+		utils.Return(w, http.StatusForbidden, resp)
+	case domain.ErrUnknownProvider:
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Kimlik sağlayıcısı bulunamadı veya etkin değil", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	case domain.ErrIdentityConflict:
+		resp := utils.ErrorResponse("CONFLICT", "Bu kullanıcı adı kimlik sağlayıcısına bağlı olmayan bir hesaba ait", err.Error())
+		utils.Return(w, http.StatusConflict, resp)
+	case domain.ErrProviderUnavailable:
+		resp := utils.ErrorResponse("PROVIDER_UNAVAILABLE", "Kimlik sağlayıcısına ulaşılamadı", e.Provider)
+		utils.Return(w, http.StatusBadGateway, resp)
+	default:
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Sunucu hatası", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+	}
+}
+
+// clientIP bağlantının uzak adresini döner. Proxy başlıklarına güvenilmez;
+// aksi halde saldırgan her denemede farklı bir IP beyan edebilirdi.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func retryAfterSeconds(d time.Duration) string {
+	seconds := int(math.Ceil(d.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return strconv.Itoa(seconds)
 }
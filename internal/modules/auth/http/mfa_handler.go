@@ -0,0 +1,145 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+func (h *AuthHandler) EnrollMFA(w http.ResponseWriter, r *http.Request) {
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	enrollResp, err := h.service.EnrollMFA(r.Context(), userID)
+	if err != nil {
+		writeMFAError(w, err, "2FA kaydı başlatılamadı")
+		return
+	}
+
+	utils.WriteJson(w, enrollResp, http.StatusOK, "Secret'ı authenticator uygulamanıza ekleyip bir kodla doğrulayın")
+}
+
+func (h *AuthHandler) ActivateMFA(w http.ResponseWriter, r *http.Request) {
+	var req domain.MFAActivateRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	activateResp, err := h.service.ActivateMFA(r.Context(), userID, &req)
+	if err != nil {
+		writeMFAError(w, err, "2FA etkinleştirilemedi")
+		return
+	}
+
+	utils.WriteJson(w, activateResp, http.StatusOK, "2FA etkinleştirildi, kurtarma kodlarını güvenli bir yerde saklayın")
+}
+
+func (h *AuthHandler) DisableMFA(w http.ResponseWriter, r *http.Request) {
+	var req domain.MFADisableRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DisableMFA(r.Context(), userID, &req); err != nil {
+		writeMFAError(w, err, "2FA kapatılamadı")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "2FA kapatıldı")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *AuthHandler) ResetMFA(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz UUID formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.service.ResetMFA(r.Context(), userID); err != nil {
+		writeMFAError(w, err, "2FA sıfırlanamadı")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Kullanıcının 2FA kaydı sıfırlandı")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *AuthHandler) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+	var req domain.MFAVerifyRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	req.IP = clientIP(r)
+
+	loginResp, err := h.service.VerifyMFA(r.Context(), &req)
+	if err != nil {
+		switch err.(type) {
+		case domain.ErrInvalidMFAToken:
+			resp := utils.ErrorResponse("UNAUTHORIZED", "Doğrulama süresi dolmuş, tekrar giriş yapın", err.Error())
+			utils.Return(w, http.StatusUnauthorized, resp)
+		case domain.ErrInvalidMFACode:
+			resp := utils.ErrorResponse("INVALID_MFA_CODE", "Doğrulama kodu hatalı", err.Error())
+			utils.Return(w, http.StatusUnauthorized, resp)
+		default:
+			writeLoginError(w, err)
+		}
+		return
+	}
+
+	utils.WriteJson(w, loginResp, http.StatusOK, "Giriş başarılı")
+}
+
+func writeMFAError(w http.ResponseWriter, err error, message string) {
+	switch err.(type) {
+	case domain.ErrMFAAlreadyEnabled:
+		resp := utils.ErrorResponse("MFA_ALREADY_ENABLED", "2FA zaten etkin", err.Error())
+		utils.Return(w, http.StatusConflict, resp)
+	case domain.ErrMFANotEnrolled:
+		resp := utils.ErrorResponse("MFA_NOT_ENROLLED", "2FA kaydı bulunamadı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	case domain.ErrInvalidMFACode:
+		resp := utils.ErrorResponse("INVALID_MFA_CODE", "Doğrulama kodu hatalı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	case domain.ErrWrongPassword:
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Mevcut şifre hatalı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	case userDomain.ErrUserNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Kullanıcı bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case userDomain.ErrManageForbidden:
+		resp := utils.ErrorResponse("FORBIDDEN", "Bu roldeki kullanıcıyı yönetmek için yetkiniz yok", "Gerekli yetki: role:manage")
+		utils.Return(w, http.StatusForbidden, resp)
+	default:
+		resp := utils.ErrorResponse("INTERNAL_ERROR", message, err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+	}
+}
+
+func currentUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(utils.GetUserIDFromContext(r.Context()))
+	if err != nil {
+		resp := utils.ErrorResponse("UNAUTHORIZED", "Giriş yapmanız gerekiyor", err.Error())
+		utils.Return(w, http.StatusUnauthorized, resp)
+		return uuid.Nil, false
+	}
+	return userID, true
+}
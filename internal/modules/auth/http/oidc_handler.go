@@ -0,0 +1,53 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+)
+
+func (h *AuthHandler) Providers(w http.ResponseWriter, r *http.Request) {
+	utils.WriteJson(w, h.service.Providers(), http.StatusOK, "Kimlik sağlayıcıları listelendi")
+}
+
+// AuthorizeOIDC istemcinin kullanıcıyı yönlendireceği sağlayıcı adresini döner. Sağlayıcı
+// kullanıcıyı OIDC_REDIRECT_URL'e code ve state ile geri gönderir; istemci bunları
+// OIDCCallback'e iletir.
+func (h *AuthHandler) AuthorizeOIDC(w http.ResponseWriter, r *http.Request) {
+	startResp, err := h.service.StartOIDC(r.Context())
+	if err != nil {
+		writeLoginError(w, err)
+		return
+	}
+
+	utils.WriteJson(w, startResp, http.StatusOK, "Kimlik sağlayıcısına yönlendirin")
+}
+
+func (h *AuthHandler) OIDCCallback(w http.ResponseWriter, r *http.Request) {
+	var req domain.OIDCCallbackRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	req.IP = clientIP(r)
+
+	loginResp, err := h.service.CompleteOIDC(r.Context(), &req)
+	if err != nil {
+		switch e := err.(type) {
+		case domain.ErrMFARequired:
+			utils.WriteJson(w, e.Challenge, http.StatusOK, "İki adımlı doğrulama gerekli")
+		case domain.ErrInvalidOIDCState:
+			resp := utils.ErrorResponse("INVALID_STATE", "Giriş isteği geçersiz veya süresi dolmuş, tekrar deneyin", err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+		case domain.ErrInvalidCredentials:
+			resp := utils.ErrorResponse("UNAUTHORIZED", "Kimlik sağlayıcısı girişi doğrulanamadı", err.Error())
+			utils.Return(w, http.StatusUnauthorized, resp)
+		default:
+			writeLoginError(w, err)
+		}
+		return
+	}
+
+	utils.WriteJson(w, loginResp, http.StatusOK, "Giriş başarılı")
+}
@@ -0,0 +1,120 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/service"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type PATHandler struct {
+	service  service.PATService
+	validate *validator.Validate
+}
+
+func NewPATHandler(svc service.PATService) *PATHandler {
+	return &PATHandler{
+		service:  svc,
+		validate: validation.Get(),
+	}
+}
+
+func (h *PATHandler) ListTokens(w http.ResponseWriter, r *http.Request) {
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	tokens, err := h.service.List(r.Context(), userID)
+	if err != nil {
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Token listesi getirilemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, tokens, http.StatusOK, "Token listesi başarıyla getirildi")
+}
+
+func (h *PATHandler) CreateToken(w http.ResponseWriter, r *http.Request) {
+	var req domain.CreatePATRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	created, err := h.service.Create(r.Context(), userID, &req)
+	if err != nil {
+		switch e := err.(type) {
+		case domain.ErrPermissionNotGranted:
+			resp := utils.ErrorResponse("VALIDATION_ERROR", "Rolünüzde olmayan bir yetki token'a verilemez", "Yetki: "+e.Permission)
+			utils.Return(w, http.StatusBadRequest, resp)
+		case domain.ErrPATNameTaken:
+			resp := utils.ErrorResponse("CONFLICT", "Bu isimde bir token zaten var", err.Error())
+			utils.Return(w, http.StatusConflict, resp)
+		case userDomain.ErrUserNotFound:
+			resp := utils.ErrorResponse("NOT_FOUND", "Kullanıcı bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+		default:
+			resp := utils.ErrorResponse("INTERNAL_ERROR", "Token oluşturulamadı", err.Error())
+			utils.Return(w, http.StatusInternalServerError, resp)
+		}
+		return
+	}
+
+	utils.WriteJson(w, created, http.StatusCreated, "Token oluşturuldu, bir daha gösterilmeyeceği için güvenli bir yerde saklayın")
+}
+
+func (h *PATHandler) DeleteToken(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz UUID formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), userID, id); err != nil {
+		if _, ok := err.(domain.ErrPATNotFound); ok {
+			resp := utils.ErrorResponse("NOT_FOUND", "Token bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+			return
+		}
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Token silinemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Token iptal edildi")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *PATHandler) decode(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	return true
+}
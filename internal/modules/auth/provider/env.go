@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/oidc"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+)
+
+// FromEnv AUTH_PROVIDERS'taki (virgülle ayrılmış; varsayılan "local") sağlayıcıları
+// LDAP_* ve OIDC_* değişkenlerinden kurar. İkinci dönüş değeri şifreyle girişte sağlayıcı
+// belirtilmediğinde kullanılan AUTH_DEFAULT_PROVIDER'dır.
+func FromEnv(userRepo userDomain.UserRepository, logger logger.Logger) ([]domain.Authenticator, string, error) {
+	names := splitList(os.Getenv("AUTH_PROVIDERS"))
+	if len(names) == 0 {
+		names = []string{Local}
+	}
+
+	var authenticators []domain.Authenticator
+	for _, name := range names {
+		switch name {
+		case Local:
+			authenticators = append(authenticators, NewLocalAuthenticator(userRepo))
+		case LDAP:
+			authenticator, err := ldapFromEnv(logger)
+			if err != nil {
+				return nil, "", err
+			}
+			authenticators = append(authenticators, authenticator)
+		case OIDC:
+			authenticator, err := oidcFromEnv(logger)
+			if err != nil {
+				return nil, "", err
+			}
+			authenticators = append(authenticators, authenticator)
+		default:
+			return nil, "", fmt.Errorf("AUTH_PROVIDERS: unknown provider %q", name)
+		}
+	}
+
+	defaultProvider := os.Getenv("AUTH_DEFAULT_PROVIDER")
+	if defaultProvider == "" {
+		defaultProvider = names[0]
+	}
+	if defaultProvider == OIDC || !contains(names, defaultProvider) {
+		return nil, "", fmt.Errorf("AUTH_DEFAULT_PROVIDER: %q must be an enabled password provider", defaultProvider)
+	}
+
+	return authenticators, defaultProvider, nil
+}
+
+func ldapFromEnv(logger logger.Logger) (*LDAPAuthenticator, error) {
+	cfg := LDAPConfig{
+		URL:               os.Getenv("LDAP_URL"),
+		StartTLS:          os.Getenv("LDAP_START_TLS") == "true",
+		BindDN:            os.Getenv("LDAP_BIND_DN"),
+		BindPassword:      os.Getenv("LDAP_BIND_PASSWORD"),
+		BaseDN:            os.Getenv("LDAP_BASE_DN"),
+		UserFilter:        os.Getenv("LDAP_USER_FILTER"),
+		UsernameAttribute: os.Getenv("LDAP_USERNAME_ATTRIBUTE"),
+		GroupAttribute:    os.Getenv("LDAP_GROUP_ATTRIBUTE"),
+		SubjectAttribute:  os.Getenv("LDAP_SUBJECT_ATTRIBUTE"),
+	}
+	if cfg.URL == "" || cfg.BaseDN == "" {
+		return nil, fmt.Errorf("ldap provider requires LDAP_URL and LDAP_BASE_DN")
+	}
+	if cfg.UserFilter != "" && !strings.Contains(cfg.UserFilter, "{username}") {
+		return nil, fmt.Errorf("LDAP_USER_FILTER must contain {username}")
+	}
+
+	roles, err := ParseRoleMapping(os.Getenv("LDAP_ROLE_MAPPING"), os.Getenv("LDAP_DEFAULT_ROLE"))
+	if err != nil {
+		return nil, fmt.Errorf("LDAP_ROLE_MAPPING: %w", err)
+	}
+	cfg.Roles = roles
+
+	return NewLDAPAuthenticator(cfg, logger), nil
+}
+
+func oidcFromEnv(logger logger.Logger) (*OIDCAuthenticator, error) {
+	cfg := OIDCConfig{
+		Config: oidc.Config{
+			IssuerURL:    os.Getenv("OIDC_ISSUER_URL"),
+			ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+			ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+			RedirectURL:  os.Getenv("OIDC_REDIRECT_URL"),
+			Scopes:       splitList(os.Getenv("OIDC_SCOPES")),
+		},
+		UsernameClaim: os.Getenv("OIDC_USERNAME_CLAIM"),
+		GroupsClaim:   os.Getenv("OIDC_GROUPS_CLAIM"),
+	}
+	if cfg.IssuerURL == "" || cfg.ClientID == "" || cfg.RedirectURL == "" {
+		return nil, fmt.Errorf("oidc provider requires OIDC_ISSUER_URL, OIDC_CLIENT_ID and OIDC_REDIRECT_URL")
+	}
+
+	roles, err := ParseRoleMapping(os.Getenv("OIDC_ROLE_MAPPING"), os.Getenv("OIDC_DEFAULT_ROLE"))
+	if err != nil {
+		return nil, fmt.Errorf("OIDC_ROLE_MAPPING: %w", err)
+	}
+	cfg.Roles = roles
+
+	return NewOIDCAuthenticator(cfg, logger), nil
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func contains(items []string, value string) bool {
+	for _, item := range items {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
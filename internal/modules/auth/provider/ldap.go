@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	"github.com/go-ldap/ldap/v3"
+)
+
+// ldapTimeout bağlantı kurma ve her LDAP isteği için beklenecek en uzun süredir.
+const ldapTimeout = 10 * time.Second
+
+type LDAPConfig struct {
+	URL      string
+	StartTLS bool
+	// TLSConfig nil ise sunucu sertifikası sistem CA'larıyla doğrulanır.
+	TLSConfig *tls.Config
+
+	// BindDN boşsa kullanıcı araması anonim yapılır.
+	BindDN       string
+	BindPassword string
+	BaseDN       string
+	// UserFilter içindeki {username} escape edilmiş kullanıcı adıyla değiştirilir.
+	UserFilter string
+
+	UsernameAttribute string
+	GroupAttribute    string
+	// SubjectAttribute boşsa hesabı eşlemek için entry DN'i kullanılır.
+	SubjectAttribute string
+
+	Roles RoleMapping
+}
+
+// LDAPAuthenticator kullanıcıyı servis hesabıyla arar ve bulunan DN ile kullanıcının
+// şifresiyle bind yaparak doğrular.
+type LDAPAuthenticator struct {
+	cfg    LDAPConfig
+	logger logger.Logger
+}
+
+func NewLDAPAuthenticator(cfg LDAPConfig, logger logger.Logger) *LDAPAuthenticator {
+	if cfg.UserFilter == "" {
+		cfg.UserFilter = "(uid={username})"
+	}
+	if cfg.UsernameAttribute == "" {
+		cfg.UsernameAttribute = "uid"
+	}
+	if cfg.GroupAttribute == "" {
+		cfg.GroupAttribute = "memberOf"
+	}
+	return &LDAPAuthenticator{cfg: cfg, logger: logger}
+}
+
+func (a *LDAPAuthenticator) Name() string {
+	return LDAP
+}
+
+func (a *LDAPAuthenticator) Authenticate(ctx context.Context, creds domain.Credentials) (*domain.Identity, error) {
+	if creds.Username == "" || creds.Password == "" {
+		return nil, domain.ErrInvalidCredentials{}
+	}
+
+	conn, err := a.connect(ctx)
+	if err != nil {
+		return nil, domain.ErrProviderUnavailable{Provider: LDAP, Err: err}
+	}
+	defer conn.Close()
+
+	if a.cfg.BindDN != "" {
+		if err := conn.Bind(a.cfg.BindDN, a.cfg.BindPassword); err != nil {
+			return nil, domain.ErrProviderUnavailable{Provider: LDAP, Err: err}
+		}
+	}
+
+	attributes := []string{a.cfg.UsernameAttribute, "mail", "givenName", "sn", a.cfg.GroupAttribute}
+	if a.cfg.SubjectAttribute != "" {
+		attributes = append(attributes, a.cfg.SubjectAttribute)
+	}
+	result, err := conn.Search(ldap.NewSearchRequest(
+		a.cfg.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, int(ldapTimeout/time.Second), false,
+		strings.ReplaceAll(a.cfg.UserFilter, "{username}", ldap.EscapeFilter(creds.Username)),
+		attributes, nil,
+	))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, domain.ErrProviderUnavailable{Provider: LDAP, Err: err}
+	}
+	if len(result.Entries) != 1 {
+		if len(result.Entries) > 1 {
+			a.logger.Info("LDAP user filter matched multiple entries", map[string]interface{}{
+				"username": creds.Username,
+			})
+		}
+		return nil, domain.ErrInvalidCredentials{}
+	}
+	entry := result.Entries[0]
+
+	if err := conn.Bind(entry.DN, creds.Password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, domain.ErrInvalidCredentials{}
+		}
+		return nil, domain.ErrProviderUnavailable{Provider: LDAP, Err: err}
+	}
+
+	subject := entry.DN
+	if a.cfg.SubjectAttribute != "" {
+		if subject = entry.GetEqualFoldAttributeValue(a.cfg.SubjectAttribute); subject == "" {
+			return nil, domain.ErrProviderUnavailable{Provider: LDAP, Err: errors.New("entry has no " + a.cfg.SubjectAttribute)}
+		}
+	}
+	username := entry.GetEqualFoldAttributeValue(a.cfg.UsernameAttribute)
+	if username == "" {
+		username = creds.Username
+	}
+
+	role, roleMapped := a.cfg.Roles.Role(entry.GetEqualFoldAttributeValues(a.cfg.GroupAttribute))
+	return &domain.Identity{
+		Provider:   LDAP,
+		Subject:    subject,
+		Username:   username,
+		Email:      entry.GetEqualFoldAttributeValue("mail"),
+		Ad:         entry.GetEqualFoldAttributeValue("givenName"),
+		Soyad:      entry.GetEqualFoldAttributeValue("sn"),
+		Role:       role,
+		RoleMapped: roleMapped,
+	}, nil
+}
+
+// connect ldap:// veya ldaps:// adresine bağlanır, gerekiyorsa StartTLS ile bağlantıyı
+// yükseltir.
+func (a *LDAPAuthenticator) connect(ctx context.Context) (*ldap.Conn, error) {
+	u, err := url.Parse(a.cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := tlsConfigFor(a.cfg.TLSConfig, u.Hostname())
+
+	dialer := &net.Dialer{Timeout: ldapTimeout}
+	if deadline, ok := ctx.Deadline(); ok {
+		dialer.Deadline = deadline
+	}
+	conn, err := ldap.DialURL(a.cfg.URL, ldap.DialWithDialer(dialer), ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(ldapTimeout)
+
+	if a.cfg.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+func tlsConfigFor(cfg *tls.Config, serverName string) *tls.Config {
+	if cfg == nil {
+		return &tls.Config{ServerName: serverName, MinVersion: tls.VersionTLS12}
+	}
+	cfg = cfg.Clone()
+	if cfg.ServerName == "" {
+		cfg.ServerName = serverName
+	}
+	return cfg
+}
@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	ber "github.com/go-asn1-ber/asn1-ber"
+)
+
+const (
+	testBaseDN  = "dc=example,dc=com"
+	testAliDN   = "uid=ali,ou=people,dc=example,dc=com"
+	testAdminDN = "cn=admin,dc=example,dc=com"
+)
+
+type testEntry struct {
+	dn         string
+	attributes map[string][]string
+}
+
+// fakeServer 127.0.0.1 üzerinde dinleyen, bind ve search isteklerine cevap veren
+// minimal bir LDAP sunucusudur.
+type fakeServer struct {
+	t         *testing.T
+	listener  net.Listener
+	passwords map[string]string
+	entries   []testEntry
+
+	mu sync.Mutex
+	// filters sunucunun aldığı ham (BER) search filtreleridir.
+	filters []*ber.Packet
+}
+
+func newFakeServer(t *testing.T, passwords map[string]string, entries ...testEntry) *fakeServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &fakeServer{t: t, listener: l, passwords: passwords, entries: entries}
+	t.Cleanup(func() { l.Close() })
+	go s.accept()
+	return s
+}
+
+func (s *fakeServer) url() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+func (s *fakeServer) accept() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.serve(conn)
+	}
+}
+
+func (s *fakeServer) serve(conn net.Conn) {
+	defer conn.Close()
+	for {
+		msg, err := ber.ReadPacket(conn)
+		if err != nil || len(msg.Children) < 2 {
+			return
+		}
+		id := msg.Children[0].Value.(int64)
+		op := msg.Children[1]
+
+		switch op.Tag {
+		case ldapBindRequest:
+			dn := op.Children[1].Data.String()
+			password := op.Children[2].Data.String()
+			code := int64(ldapInvalidCredentials)
+			if want, ok := s.passwords[dn]; ok && want == password {
+				code = ldapSuccess
+			}
+			s.reply(conn, id, ldapBindResponse, code)
+		case ldapSearchRequest:
+			s.search(conn, id, op)
+		case ldapUnbindRequest:
+			return
+		}
+	}
+}
+
+func (s *fakeServer) search(conn net.Conn, id int64, op *ber.Packet) {
+	s.mu.Lock()
+	s.filters = append(s.filters, op.Children[6])
+	s.mu.Unlock()
+
+	sizeLimit := int(op.Children[3].Value.(int64))
+	for i, e := range s.entries {
+		if sizeLimit > 0 && i == sizeLimit {
+			s.reply(conn, id, ldapSearchResultDone, ldapSizeLimitExceeded)
+			return
+		}
+		entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldapSearchResultEntry, nil, "")
+		entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.dn, ""))
+		attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+		for name, values := range e.attributes {
+			attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+			attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, ""))
+			set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "")
+			for _, v := range values {
+				set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, ""))
+			}
+			attr.AppendChild(set)
+			attrs.AppendChild(attr)
+		}
+		entry.AppendChild(attrs)
+		s.write(conn, id, entry)
+	}
+	s.reply(conn, id, ldapSearchResultDone, ldapSuccess)
+}
+
+func (s *fakeServer) reply(conn net.Conn, id int64, tag ber.Tag, code int64) {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, ""))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+	s.write(conn, id, op)
+}
+
+func (s *fakeServer) write(conn net.Conn, id int64, op *ber.Packet) {
+	msg := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+	msg.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, ""))
+	msg.AppendChild(op)
+	if _, err := conn.Write(msg.Bytes()); err != nil {
+		s.t.Errorf("fake server write: %v", err)
+	}
+}
+
+const (
+	ldapBindRequest       ber.Tag = 0
+	ldapBindResponse      ber.Tag = 1
+	ldapUnbindRequest     ber.Tag = 2
+	ldapSearchRequest     ber.Tag = 3
+	ldapSearchResultEntry ber.Tag = 4
+	ldapSearchResultDone  ber.Tag = 5
+
+	ldapSuccess            = 0
+	ldapSizeLimitExceeded  = 4
+	ldapInvalidCredentials = 49
+)
+
+func aliEntry() testEntry {
+	return testEntry{dn: testAliDN, attributes: map[string][]string{
+		"uid":       {"ali"},
+		"mail":      {"ali@example.com"},
+		"givenName": {"Ali"},
+		"sn":        {"Yılmaz"},
+		"memberOf":  {"cn=admins,ou=groups,dc=example,dc=com"},
+		"entryUUID": {"0b6f1c5e-7d8a-4a59-9d1b-3f1f0a6c2e11"},
+	}}
+}
+
+func newTestAuthenticator(t *testing.T, srv *fakeServer, cfg LDAPConfig) *LDAPAuthenticator {
+	t.Helper()
+	roles, err := ParseRoleMapping("admins=ADMIN", "USER")
+	if err != nil {
+		t.Fatalf("ParseRoleMapping() error = %v", err)
+	}
+	cfg.URL = srv.url()
+	cfg.BaseDN = testBaseDN
+	cfg.Roles = roles
+	return NewLDAPAuthenticator(cfg, logger.NewMockLogger())
+}
+
+func TestLDAPAuthenticate(t *testing.T) {
+	srv := newFakeServer(t, map[string]string{testAliDN: "secret", testAdminDN: "admin"}, aliEntry())
+	a := newTestAuthenticator(t, srv, LDAPConfig{BindDN: testAdminDN, BindPassword: "admin"})
+
+	identity, err := a.Authenticate(context.Background(), domain.Credentials{Username: "ali", Password: "secret"})
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	want := domain.Identity{
+		Provider:   LDAP,
+		Subject:    testAliDN,
+		Username:   "ali",
+		Email:      "ali@example.com",
+		Ad:         "Ali",
+		Soyad:      "Yılmaz",
+		Role:       "ADMIN",
+		RoleMapped: true,
+	}
+	if *identity != want {
+		t.Fatalf("Authenticate() = %+v, want %+v", *identity, want)
+	}
+}
+
+func TestLDAPAuthenticateSubjectAttribute(t *testing.T) {
+	srv := newFakeServer(t, map[string]string{testAliDN: "secret"}, aliEntry())
+
+	a := newTestAuthenticator(t, srv, LDAPConfig{SubjectAttribute: "entryuuid"})
+	identity, err := a.Authenticate(context.Background(), domain.Credentials{Username: "ali", Password: "secret"})
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if identity.Subject != "0b6f1c5e-7d8a-4a59-9d1b-3f1f0a6c2e11" {
+		t.Fatalf("Subject = %q, want entryUUID değeri", identity.Subject)
+	}
+
+	// Subject özniteliği olmayan bir entry hesapla eşlenemez.
+	a = newTestAuthenticator(t, srv, LDAPConfig{SubjectAttribute: "objectGUID"})
+	_, err = a.Authenticate(context.Background(), domain.Credentials{Username: "ali", Password: "secret"})
+	var unavailable domain.ErrProviderUnavailable
+	if !errors.As(err, &unavailable) {
+		t.Fatalf("Authenticate() error = %v, want ErrProviderUnavailable", err)
+	}
+}
+
+func TestLDAPAuthenticateInvalidCredentials(t *testing.T) {
+	second := aliEntry()
+	second.dn = "uid=ali,ou=contractors,dc=example,dc=com"
+
+	tests := []struct {
+		name     string
+		entries  []testEntry
+		username string
+		password string
+	}{
+		{"yanlış şifre", []testEntry{aliEntry()}, "ali", "wrong"},
+		{"boş şifre", []testEntry{aliEntry()}, "ali", ""},
+		{"bilinmeyen kullanıcı", nil, "veli", "secret"},
+		{"birden fazla eşleşme", []testEntry{aliEntry(), second, aliEntry()}, "ali", "secret"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newFakeServer(t, map[string]string{testAliDN: "secret"}, tt.entries...)
+			a := newTestAuthenticator(t, srv, LDAPConfig{})
+
+			_, err := a.Authenticate(context.Background(), domain.Credentials{Username: tt.username, Password: tt.password})
+			var invalid domain.ErrInvalidCredentials
+			if !errors.As(err, &invalid) {
+				t.Fatalf("Authenticate() error = %v, want ErrInvalidCredentials", err)
+			}
+		})
+	}
+}
+
+func TestLDAPAuthenticateUnavailable(t *testing.T) {
+	t.Run("servis hesabı bind edilemiyor", func(t *testing.T) {
+		srv := newFakeServer(t, map[string]string{testAliDN: "secret", testAdminDN: "admin"}, aliEntry())
+		a := newTestAuthenticator(t, srv, LDAPConfig{BindDN: testAdminDN, BindPassword: "wrong"})
+
+		_, err := a.Authenticate(context.Background(), domain.Credentials{Username: "ali", Password: "secret"})
+		var unavailable domain.ErrProviderUnavailable
+		if !errors.As(err, &unavailable) {
+			t.Fatalf("Authenticate() error = %v, want ErrProviderUnavailable", err)
+		}
+	})
+
+	t.Run("sunucuya ulaşılamıyor", func(t *testing.T) {
+		srv := newFakeServer(t, nil)
+		a := newTestAuthenticator(t, srv, LDAPConfig{})
+		srv.listener.Close()
+
+		_, err := a.Authenticate(context.Background(), domain.Credentials{Username: "ali", Password: "secret"})
+		var unavailable domain.ErrProviderUnavailable
+		if !errors.As(err, &unavailable) {
+			t.Fatalf("Authenticate() error = %v, want ErrProviderUnavailable", err)
+		}
+	})
+}
+
+func TestLDAPUsernameIsSentAsEqualityMatch(t *testing.T) {
+	srv := newFakeServer(t, nil)
+	a := newTestAuthenticator(t, srv, LDAPConfig{})
+
+	// Filtre metakarakterleri escape edilmezse bu kullanıcı adı filtreyi genişletirdi.
+	username := "*)(|(uid=*\\\x00"
+	_, err := a.Authenticate(context.Background(), domain.Credentials{Username: username, Password: "secret"})
+	var invalid domain.ErrInvalidCredentials
+	if !errors.As(err, &invalid) {
+		t.Fatalf("Authenticate() error = %v, want ErrInvalidCredentials", err)
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if len(srv.filters) != 1 {
+		t.Fatalf("server received %d search requests, want 1", len(srv.filters))
+	}
+	f := srv.filters[0]
+	const equalityMatch ber.Tag = 3
+	if f.ClassType != ber.ClassContext || f.Tag != equalityMatch || len(f.Children) != 2 {
+		t.Fatalf("filter = class %d tag %d, want equalityMatch", f.ClassType, f.Tag)
+	}
+	if attr := f.Children[0].Data.String(); attr != "uid" {
+		t.Fatalf("filter attribute = %q, want uid", attr)
+	}
+	if value := f.Children[1].Data.String(); value != username {
+		t.Fatalf("filter value = %q, want %q", value, username)
+	}
+}
@@ -0,0 +1,52 @@
+// Package provider auth servisinin kullandığı kimlik sağlayıcılarıdır: yerel şifre, LDAP
+// bind ve OIDC authorization code + PKCE.
+package provider
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	Local = "local"
+	LDAP  = "ldap"
+	OIDC  = "oidc"
+)
+
+// LocalAuthenticator kullanıcıları users tablosundaki bcrypt hash'ine karşı doğrular.
+type LocalAuthenticator struct {
+	userRepo userDomain.UserRepository
+}
+
+func NewLocalAuthenticator(userRepo userDomain.UserRepository) *LocalAuthenticator {
+	return &LocalAuthenticator{userRepo: userRepo}
+}
+
+func (a *LocalAuthenticator) Name() string {
+	return Local
+}
+
+func (a *LocalAuthenticator) Authenticate(ctx context.Context, creds domain.Credentials) (*domain.Identity, error) {
+	user, err := a.userRepo.GetByUsername(ctx, creds.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrInvalidCredentials{}
+		}
+		return nil, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(creds.Password)); err != nil {
+		return nil, domain.ErrInvalidCredentials{}
+	}
+
+	return &domain.Identity{
+		Provider: Local,
+		Subject:  user.Id.String(),
+		Username: user.Username,
+		User:     user,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/oidc"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type OIDCConfig struct {
+	oidc.Config
+
+	// UsernameClaim yoksa email, o da yoksa sub kullanılır.
+	UsernameClaim string
+	GroupsClaim   string
+	Roles         RoleMapping
+}
+
+// OIDCAuthenticator authorization code + PKCE akışıyla çalışır; Authenticate callback'te
+// gelen kodu token'lara çevirip id_token'ı doğrular.
+type OIDCAuthenticator struct {
+	cfg      OIDCConfig
+	provider *oidc.Provider
+	logger   logger.Logger
+}
+
+func NewOIDCAuthenticator(cfg OIDCConfig, logger logger.Logger) *OIDCAuthenticator {
+	if cfg.UsernameClaim == "" {
+		cfg.UsernameClaim = "preferred_username"
+	}
+	if cfg.GroupsClaim == "" {
+		cfg.GroupsClaim = "groups"
+	}
+	return &OIDCAuthenticator{
+		cfg:      cfg,
+		provider: oidc.NewProvider(cfg.Config),
+		logger:   logger,
+	}
+}
+
+func (a *OIDCAuthenticator) Name() string {
+	return OIDC
+}
+
+func (a *OIDCAuthenticator) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	authURL, err := a.provider.AuthCodeURL(ctx, state, nonce, codeChallenge)
+	if err != nil {
+		return "", domain.ErrProviderUnavailable{Provider: OIDC, Err: err}
+	}
+	return authURL, nil
+}
+
+func (a *OIDCAuthenticator) Authenticate(ctx context.Context, creds domain.Credentials) (*domain.Identity, error) {
+	if creds.Code == "" || creds.CodeVerifier == "" {
+		return nil, domain.ErrInvalidCredentials{}
+	}
+
+	tokens, err := a.provider.Exchange(ctx, creds.Code, creds.CodeVerifier)
+	if err != nil {
+		var statusErr *oidc.StatusError
+		if errors.As(err, &statusErr) && statusErr.StatusCode >= 400 && statusErr.StatusCode < 500 {
+			a.logger.Error("OIDC code exchange rejected", err, nil)
+			return nil, domain.ErrInvalidCredentials{}
+		}
+		return nil, domain.ErrProviderUnavailable{Provider: OIDC, Err: err}
+	}
+
+	claims, err := a.provider.VerifyIDToken(ctx, tokens.IDToken, creds.Nonce)
+	if err != nil {
+		a.logger.Error("Failed to verify OIDC id_token", err, nil)
+		return nil, domain.ErrInvalidCredentials{}
+	}
+
+	subject, _ := claims["sub"].(string)
+	email := stringClaim(claims, "email")
+	username := stringClaim(claims, a.cfg.UsernameClaim)
+	if username == "" {
+		username = email
+	}
+	if username == "" {
+		username = subject
+	}
+
+	role, roleMapped := a.cfg.Roles.Role(groupsClaim(claims, a.cfg.GroupsClaim))
+	return &domain.Identity{
+		Provider:   OIDC,
+		Subject:    subject,
+		Username:   username,
+		Email:      email,
+		Ad:         stringClaim(claims, "given_name"),
+		Soyad:      stringClaim(claims, "family_name"),
+		Role:       role,
+		RoleMapped: roleMapped,
+	}, nil
+}
+
+func stringClaim(claims jwt.MapClaims, name string) string {
+	value, _ := claims[name].(string)
+	return value
+}
+
+// groupsClaim dizi veya boşluk/virgülle ayrılmış string olarak gelen grupları okur.
+func groupsClaim(claims jwt.MapClaims, name string) []string {
+	switch value := claims[name].(type) {
+	case []interface{}:
+		groups := make([]string, 0, len(value))
+		for _, v := range value {
+			if group, ok := v.(string); ok {
+				groups = append(groups, group)
+			}
+		}
+		return groups
+	case string:
+		return strings.FieldsFunc(value, func(r rune) bool { return r == ' ' || r == ',' })
+	}
+	return nil
+}
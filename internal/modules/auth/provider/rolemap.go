@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+const defaultProvisionRole = "USER"
+
+// RoleMapping dış sağlayıcıdan gelen grupları yerel rollere çevirir. Kurallar yazıldığı
+// sırayla denenir; ilk eşleşen kazanır, hiçbiri eşleşmezse DefaultRole verilir.
+type RoleMapping struct {
+	rules       []roleRule
+	DefaultRole string
+}
+
+type roleRule struct {
+	group string
+	role  string
+}
+
+// ParseRoleMapping "grup=ROL;grup2=ROL2" biçimini okur. Grup adları LDAP DN'i olabileceği
+// için (içinde "=" geçer) her kural son "=" işaretinden bölünür.
+func ParseRoleMapping(spec, defaultRole string) (RoleMapping, error) {
+	if defaultRole == "" {
+		defaultRole = defaultProvisionRole
+	}
+	mapping := RoleMapping{DefaultRole: defaultRole}
+
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		i := strings.LastIndex(entry, "=")
+		if i <= 0 || i == len(entry)-1 {
+			return RoleMapping{}, fmt.Errorf("invalid role mapping %q, expected group=ROLE", entry)
+		}
+		mapping.rules = append(mapping.rules, roleRule{
+			group: strings.TrimSpace(entry[:i]),
+			role:  strings.TrimSpace(entry[i+1:]),
+		})
+	}
+	return mapping, nil
+}
+
+// Role gruplar için eşlenen rolü döner. Bir grup kuraldaki adla birebir veya DN ise ilk
+// RDN değeriyle (cn=admins,ou=groups,... → admins) eşleşir; karşılaştırma harf duyarsızdır.
+// matched false ise hiçbir kural eşleşmemiş ve DefaultRole dönmüştür.
+func (m RoleMapping) Role(groups []string) (role string, matched bool) {
+	for _, rule := range m.rules {
+		for _, group := range groups {
+			if strings.EqualFold(group, rule.group) || strings.EqualFold(firstRDNValue(group), rule.group) {
+				return rule.role, true
+			}
+		}
+	}
+	return m.DefaultRole, false
+}
+
+func firstRDNValue(dn string) string {
+	rdn, _, _ := strings.Cut(dn, ",")
+	_, value, ok := strings.Cut(rdn, "=")
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(value)
+}
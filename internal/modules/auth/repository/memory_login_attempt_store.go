@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+)
+
+// MemoryLoginAttemptStore testler ve tek instance'lı geliştirme ortamı içindir;
+// sayaçlar yeniden başlatmada kaybolur ve instance'lar arasında paylaşılmaz.
+type MemoryLoginAttemptStore struct {
+	mu       sync.Mutex
+	attempts map[string]domain.LoginAttempt
+	now      func() time.Time
+}
+
+func NewMemoryLoginAttemptStore() domain.LoginAttemptStore {
+	return &MemoryLoginAttemptStore{
+		attempts: make(map[string]domain.LoginAttempt),
+		now:      time.Now,
+	}
+}
+
+// WithClock son hata zamanı ve window hesabında kullanılan saati değiştirir; testlerde
+// servisle aynı sabit saati kullanmak içindir.
+func (s *MemoryLoginAttemptStore) WithClock(now func() time.Time) *MemoryLoginAttemptStore {
+	s.now = now
+	return s
+}
+
+func (s *MemoryLoginAttemptStore) Get(ctx context.Context, key string) (*domain.LoginAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attempt, ok := s.attempts[key]
+	if !ok {
+		return nil, nil
+	}
+	return &attempt, nil
+}
+
+func (s *MemoryLoginAttemptStore) RecordFailure(ctx context.Context, key string, window time.Duration) (*domain.LoginAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	attempt, ok := s.attempts[key]
+	if !ok || now.Sub(attempt.LastFailedAt) > window {
+		attempt.Key = key
+		attempt.Failures = 0
+	}
+	attempt.Failures++
+	attempt.LastFailedAt = now
+	s.attempts[key] = attempt
+
+	return &attempt, nil
+}
+
+func (s *MemoryLoginAttemptStore) Lock(ctx context.Context, key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if attempt, ok := s.attempts[key]; ok {
+		attempt.LockedUntil = &until
+		s.attempts[key] = attempt
+	}
+	return nil
+}
+
+func (s *MemoryLoginAttemptStore) Reset(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.attempts, key)
+	return nil
+}
+
+func (s *MemoryLoginAttemptStore) Prune(ctx context.Context, before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+	for key, attempt := range s.attempts {
+		if attempt.LastFailedAt.Before(before) && (attempt.LockedUntil == nil || attempt.LockedUntil.Before(before)) {
+			delete(s.attempts, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type PostgresIdentityRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresIdentityRepository(db *sqlx.DB) domain.IdentityRepository {
+	return &PostgresIdentityRepository{db: db}
+}
+
+func (r *PostgresIdentityRepository) GetUserID(ctx context.Context, provider, subject string) (*uuid.UUID, error) {
+	var userID uuid.UUID
+	query := `SELECT user_id FROM user_identities WHERE provider = $1 AND subject = $2`
+	err := r.db.GetContext(ctx, &userID, query, provider, subject)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &userID, nil
+}
+
+func (r *PostgresIdentityRepository) Link(ctx context.Context, tx *sqlx.Tx, provider, subject string, userID uuid.UUID) error {
+	query := `
+		INSERT INTO user_identities (provider, subject, user_id, last_login_at)
+		VALUES ($1, $2, $3, NOW())
+	`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query, provider, subject, userID)
+	return err
+}
+
+func (r *PostgresIdentityRepository) TouchLogin(ctx context.Context, provider, subject string) error {
+	query := `UPDATE user_identities SET last_login_at = NOW() WHERE provider = $1 AND subject = $2`
+	_, err := r.db.ExecContext(ctx, query, provider, subject)
+	return err
+}
+
+type PostgresOIDCStateRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresOIDCStateRepository(db *sqlx.DB) domain.OIDCStateRepository {
+	return &PostgresOIDCStateRepository{db: db}
+}
+
+func (r *PostgresOIDCStateRepository) Create(ctx context.Context, req *domain.OIDCAuthRequest) error {
+	// Süresi dolmuş ve hiç tamamlanmamış istekler burada temizlenir.
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM oidc_auth_requests WHERE expires_at < NOW()`); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO oidc_auth_requests (state, nonce, code_verifier, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := r.db.ExecContext(ctx, query, req.State, req.Nonce, req.CodeVerifier, req.ExpiresAt, req.CreatedAt)
+	return err
+}
+
+func (r *PostgresOIDCStateRepository) Consume(ctx context.Context, state string) (*domain.OIDCAuthRequest, error) {
+	req := &domain.OIDCAuthRequest{}
+	query := `
+		DELETE FROM oidc_auth_requests
+		WHERE state = $1
+		RETURNING state, nonce, code_verifier, expires_at, created_at
+	`
+	err := r.db.GetContext(ctx, req, query, state)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return req, nil
+}
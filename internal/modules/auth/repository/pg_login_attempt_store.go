@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type PostgresLoginAttemptStore struct {
+	db *sqlx.DB
+}
+
+func NewPostgresLoginAttemptStore(db *sqlx.DB) domain.LoginAttemptStore {
+	return &PostgresLoginAttemptStore{db: db}
+}
+
+func (s *PostgresLoginAttemptStore) Get(ctx context.Context, key string) (*domain.LoginAttempt, error) {
+	attempt := &domain.LoginAttempt{}
+	query := `SELECT key, failures, last_failed_at, locked_until FROM login_attempts WHERE key = $1`
+	err := s.db.GetContext(ctx, attempt, query, key)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return attempt, nil
+}
+
+func (s *PostgresLoginAttemptStore) RecordFailure(ctx context.Context, key string, window time.Duration) (*domain.LoginAttempt, error) {
+	attempt := &domain.LoginAttempt{}
+	query := `
+		INSERT INTO login_attempts (key, failures, last_failed_at)
+		VALUES ($1, 1, NOW())
+		ON CONFLICT (key) DO UPDATE SET
+			failures = CASE
+				WHEN login_attempts.last_failed_at < NOW() - make_interval(secs => $2) THEN 1
+				ELSE login_attempts.failures + 1
+			END,
+			last_failed_at = NOW()
+		RETURNING key, failures, last_failed_at, locked_until
+	`
+	if err := s.db.GetContext(ctx, attempt, query, key, window.Seconds()); err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+func (s *PostgresLoginAttemptStore) Lock(ctx context.Context, key string, until time.Time) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE login_attempts SET locked_until = $2 WHERE key = $1`, key, until)
+	return err
+}
+
+func (s *PostgresLoginAttemptStore) Reset(ctx context.Context, key string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM login_attempts WHERE key = $1`, key)
+	return err
+}
+
+func (s *PostgresLoginAttemptStore) Prune(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM login_attempts WHERE last_failed_at < $1 AND (locked_until IS NULL OR locked_until < $1)`
+	res, err := s.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
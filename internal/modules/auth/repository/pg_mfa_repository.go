@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type PostgresMFARepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresMFARepository(db *sqlx.DB) domain.MFARepository {
+	return &PostgresMFARepository{db: db}
+}
+
+func (r *PostgresMFARepository) Get(ctx context.Context, userID uuid.UUID) (*domain.UserMFA, error) {
+	mfa := &domain.UserMFA{}
+	query := `
+		SELECT user_id, secret, enabled_at, last_used_step, created_at
+		FROM user_mfa
+		WHERE user_id = $1
+	`
+	err := r.db.GetContext(ctx, mfa, query, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return mfa, nil
+}
+
+func (r *PostgresMFARepository) SavePending(ctx context.Context, userID uuid.UUID, secret string) error {
+	query := `
+		INSERT INTO user_mfa (user_id, secret, created_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, enabled_at = NULL, last_used_step = NULL, created_at = NOW()
+		WHERE user_mfa.enabled_at IS NULL
+	`
+	_, err := r.db.ExecContext(ctx, query, userID, secret)
+	return err
+}
+
+func (r *PostgresMFARepository) Enable(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID, step int64) error {
+	query := `UPDATE user_mfa SET enabled_at = NOW(), last_used_step = $2 WHERE user_id = $1 AND enabled_at IS NULL`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	result, err := executor.ExecContext(ctx, query, userID, step)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrMFANotEnrolled{}
+	}
+	return nil
+}
+
+func (r *PostgresMFARepository) ConsumeStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	query := `
+		UPDATE user_mfa SET last_used_step = $2
+		WHERE user_id = $1 AND (last_used_step IS NULL OR last_used_step < $2)
+	`
+	result, err := r.db.ExecContext(ctx, query, userID, step)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *PostgresMFARepository) Delete(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID) error {
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	if _, err := executor.ExecContext(ctx, `DELETE FROM mfa_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+	_, err := executor.ExecContext(ctx, `DELETE FROM user_mfa WHERE user_id = $1`, userID)
+	return err
+}
+
+func (r *PostgresMFARepository) ReplaceRecoveryCodes(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID, codeHashes []string) error {
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	if _, err := executor.ExecContext(ctx, `DELETE FROM mfa_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+	for _, hash := range codeHashes {
+		query := `INSERT INTO mfa_recovery_codes (user_id, code_hash) VALUES ($1, $2)`
+		if _, err := executor.ExecContext(ctx, query, userID, hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *PostgresMFARepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	query := `
+		UPDATE mfa_recovery_codes SET used_at = NOW()
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+	`
+	result, err := r.db.ExecContext(ctx, query, userID, codeHash)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
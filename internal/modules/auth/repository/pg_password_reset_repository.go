@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type PostgresPasswordResetRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresPasswordResetRepository(db *sqlx.DB) domain.PasswordResetRepository {
+	return &PostgresPasswordResetRepository{db: db}
+}
+
+func (r *PostgresPasswordResetRepository) Create(ctx context.Context, tx *sqlx.Tx, token *domain.PasswordResetToken) error {
+	query := `
+		INSERT INTO password_reset_tokens (id, user_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query, token.ID, token.UserID, token.TokenHash, token.ExpiresAt, token.CreatedAt)
+	return err
+}
+
+func (r *PostgresPasswordResetRepository) InvalidateForUser(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID) error {
+	query := `UPDATE password_reset_tokens SET used_at = NOW() WHERE user_id = $1 AND used_at IS NULL`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query, userID)
+	return err
+}
+
+func (r *PostgresPasswordResetRepository) GetByHashForUpdate(ctx context.Context, tx *sqlx.Tx, tokenHash string) (*domain.PasswordResetToken, error) {
+	token := &domain.PasswordResetToken{}
+	query := `
+		SELECT id, user_id, token_hash, expires_at, used_at, created_at
+		FROM password_reset_tokens
+		WHERE token_hash = $1
+		FOR UPDATE
+	`
+	err := sqlx.GetContext(ctx, tx, token, query, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+func (r *PostgresPasswordResetRepository) MarkUsed(ctx context.Context, tx *sqlx.Tx, id uuid.UUID) error {
+	_, err := tx.ExecContext(ctx, `UPDATE password_reset_tokens SET used_at = NOW() WHERE id = $1`, id)
+	return err
+}
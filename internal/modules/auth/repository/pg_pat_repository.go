@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const patColumns = `id, user_id, name, token_hash, token_prefix, permissions, expires_at, last_used_at, created_at`
+
+type PostgresPATRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresPATRepository(db *sqlx.DB) domain.PATRepository {
+	return &PostgresPATRepository{db: db}
+}
+
+func (r *PostgresPATRepository) Create(ctx context.Context, token *domain.PersonalAccessToken) error {
+	query := `
+		INSERT INTO personal_access_tokens (id, user_id, name, token_hash, token_prefix, permissions, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		token.ID, token.UserID, token.Name, token.TokenHash, token.Prefix,
+		token.Permissions, token.ExpiresAt, token.CreatedAt,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return domain.ErrPATNameTaken{}
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresPATRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]domain.PersonalAccessToken, error) {
+	tokens := []domain.PersonalAccessToken{}
+	query := `SELECT ` + patColumns + ` FROM personal_access_tokens WHERE user_id = $1 ORDER BY created_at DESC`
+	if err := r.db.SelectContext(ctx, &tokens, query, userID); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (r *PostgresPATRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	token := &domain.PersonalAccessToken{}
+	query := `SELECT ` + patColumns + ` FROM personal_access_tokens WHERE token_hash = $1`
+	err := r.db.GetContext(ctx, token, query, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+func (r *PostgresPATRepository) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM personal_access_tokens WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrPATNotFound{}
+	}
+	return nil
+}
+
+func (r *PostgresPATRepository) TouchLastUsed(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE personal_access_tokens SET last_used_at = NOW()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')
+	`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type PostgresRefreshTokenRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRefreshTokenRepository(db *sqlx.DB) domain.RefreshTokenRepository {
+	return &PostgresRefreshTokenRepository{db: db}
+}
+
+func (r *PostgresRefreshTokenRepository) Create(ctx context.Context, tx *sqlx.Tx, token *domain.RefreshToken) error {
+	query := `
+		INSERT INTO refresh_tokens (id, user_id, family_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query,
+		token.ID, token.UserID, token.FamilyID, token.TokenHash, token.ExpiresAt, token.CreatedAt)
+	return err
+}
+
+func (r *PostgresRefreshTokenRepository) GetByHashForUpdate(ctx context.Context, tx *sqlx.Tx, tokenHash string) (*domain.RefreshToken, error) {
+	token := &domain.RefreshToken{}
+	query := `
+		SELECT id, user_id, family_id, token_hash, expires_at, used_at, revoked_at, created_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+		FOR UPDATE
+	`
+	err := sqlx.GetContext(ctx, tx, token, query, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+func (r *PostgresRefreshTokenRepository) MarkUsed(ctx context.Context, tx *sqlx.Tx, id uuid.UUID) error {
+	_, err := tx.ExecContext(ctx, `UPDATE refresh_tokens SET used_at = NOW() WHERE id = $1`, id)
+	return err
+}
+
+func (r *PostgresRefreshTokenRepository) RevokeFamily(ctx context.Context, tx *sqlx.Tx, familyID uuid.UUID) error {
+	query := `UPDATE refresh_tokens SET revoked_at = NOW() WHERE family_id = $1 AND revoked_at IS NULL`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query, familyID)
+	return err
+}
+
+func (r *PostgresRefreshTokenRepository) RevokeByUser(ctx context.Context, userID uuid.UUID) error {
+	query := `UPDATE refresh_tokens SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`
+	_, err := r.db.ExecContext(ctx, query, userID)
+	return err
+}
+
+type PostgresRevokedTokenRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRevokedTokenRepository(db *sqlx.DB) domain.RevokedTokenRepository {
+	return &PostgresRevokedTokenRepository{db: db}
+}
+
+func (r *PostgresRevokedTokenRepository) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	query := `
+		INSERT INTO revoked_tokens (jti, expires_at)
+		VALUES ($1, $2)
+		ON CONFLICT (jti) DO NOTHING
+	`
+	if _, err := r.db.ExecContext(ctx, query, jti, expiresAt); err != nil {
+		return err
+	}
+
+	_, err := r.db.ExecContext(ctx, `DELETE FROM revoked_tokens WHERE expires_at < NOW()`)
+	return err
+}
+
+func (r *PostgresRevokedTokenRepository) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	var revoked bool
+	query := `SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1)`
+	if err := r.db.GetContext(ctx, &revoked, query, jti); err != nil {
+		return false, err
+	}
+	return revoked, nil
+}
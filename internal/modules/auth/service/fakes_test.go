@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/outbox"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/token"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/repository"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// testNow bir TOTP adımının ortasındadır; ±1 adımlık kaymalar aynı dakikada kalır.
+var testNow = time.Date(2026, 3, 1, 12, 0, 15, 0, time.UTC)
+
+const testPassword = "Secret123!"
+
+// testClock servis, token manager ve login attempt store'un paylaştığı sabit saattir.
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+// testEnv authService'i bellek içi repository'lerle kurar.
+type testEnv struct {
+	svc      *authService
+	clock    *testClock
+	user     *userDomain.User
+	users    *fakeUserRepo
+	mfa      *fakeMFARepo
+	attempts domain.LoginAttemptStore
+	outbox   *fakeOutbox
+	logger   *logger.MockLogger
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	clock := &testClock{now: testNow}
+	tokens, err := token.NewManager(token.NewHMACKey([]byte("test-secret"), "test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokens.WithClock(clock.Now)
+
+	user := &userDomain.User{
+		Id:       uuid.New(),
+		Username: "ali",
+		Role:     "USER",
+		Status:   userDomain.StatusActive,
+	}
+	users := &fakeUserRepo{users: map[uuid.UUID]*userDomain.User{user.Id: user}}
+	mfa := &fakeMFARepo{settings: map[uuid.UUID]*domain.UserMFA{}, recovery: map[uuid.UUID]map[string]bool{}}
+	attempts := repository.NewMemoryLoginAttemptStore().(*repository.MemoryLoginAttemptStore).WithClock(clock.Now)
+	outboxRepo := &fakeOutbox{}
+	log := logger.NewMockLogger()
+
+	svc := NewService(users, nil, &fakeRefreshRepo{}, &fakeRevokedRepo{revoked: map[string]bool{}}, nil, mfa, nil, nil,
+		[]domain.Authenticator{&fakeAuthenticator{users: users}}, "local", attempts, outboxRepo, tokens, fakeUnitOfWork{}, log).(*authService)
+	svc.now = clock.Now
+
+	return &testEnv{
+		svc:      svc,
+		clock:    clock,
+		user:     user,
+		users:    users,
+		mfa:      mfa,
+		attempts: attempts,
+		outbox:   outboxRepo,
+		logger:   log,
+	}
+}
+
+func (e *testEnv) login(password string) (*domain.LoginResponse, error) {
+	return e.svc.Login(context.Background(), &domain.LoginRequest{
+		Username: e.user.Username,
+		Password: password,
+		IP:       "10.0.0.1",
+	})
+}
+
+type fakeUnitOfWork struct{}
+
+func (fakeUnitOfWork) Do(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	return fn(nil)
+}
+
+// fakeAuthenticator testPassword'ü kabul eden yerel sağlayıcıdır.
+type fakeAuthenticator struct {
+	users *fakeUserRepo
+}
+
+func (a *fakeAuthenticator) Name() string {
+	return "local"
+}
+
+func (a *fakeAuthenticator) Authenticate(ctx context.Context, creds domain.Credentials) (*domain.Identity, error) {
+	user, err := a.users.GetByUsername(ctx, creds.Username)
+	if err != nil || creds.Password != testPassword {
+		return nil, domain.ErrInvalidCredentials{}
+	}
+	return &domain.Identity{Provider: "local", Subject: user.Id.String(), User: user}, nil
+}
+
+type fakeUserRepo struct {
+	userDomain.UserRepository
+	users map[uuid.UUID]*userDomain.User
+}
+
+func (r *fakeUserRepo) GetByUserID(ctx context.Context, userID uuid.UUID) (*userDomain.User, error) {
+	user, ok := r.users[userID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (r *fakeUserRepo) GetByUsername(ctx context.Context, username string) (*userDomain.User, error) {
+	for _, user := range r.users {
+		if user.Username == username {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+type fakeMFARepo struct {
+	settings map[uuid.UUID]*domain.UserMFA
+	// recovery kullanıcı başına kod hash'i -> kullanıldı mı.
+	recovery map[uuid.UUID]map[string]bool
+}
+
+func (r *fakeMFARepo) Get(ctx context.Context, userID uuid.UUID) (*domain.UserMFA, error) {
+	mfa, ok := r.settings[userID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *mfa
+	return &copied, nil
+}
+
+func (r *fakeMFARepo) SavePending(ctx context.Context, userID uuid.UUID, secret string) error {
+	r.settings[userID] = &domain.UserMFA{UserID: userID, Secret: secret}
+	return nil
+}
+
+func (r *fakeMFARepo) Enable(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID, step int64) error {
+	mfa, ok := r.settings[userID]
+	if !ok || mfa.Enabled() {
+		return domain.ErrMFANotEnrolled{}
+	}
+	now := time.Now()
+	mfa.EnabledAt, mfa.LastUsedStep = &now, &step
+	return nil
+}
+
+func (r *fakeMFARepo) ConsumeStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	mfa, ok := r.settings[userID]
+	if !ok || (mfa.LastUsedStep != nil && *mfa.LastUsedStep >= step) {
+		return false, nil
+	}
+	mfa.LastUsedStep = &step
+	return true, nil
+}
+
+func (r *fakeMFARepo) Delete(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID) error {
+	delete(r.settings, userID)
+	delete(r.recovery, userID)
+	return nil
+}
+
+func (r *fakeMFARepo) ReplaceRecoveryCodes(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID, codeHashes []string) error {
+	codes := make(map[string]bool, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes[hash] = false
+	}
+	r.recovery[userID] = codes
+	return nil
+}
+
+func (r *fakeMFARepo) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	used, ok := r.recovery[userID][codeHash]
+	if !ok || used {
+		return false, nil
+	}
+	r.recovery[userID][codeHash] = true
+	return true, nil
+}
+
+type fakeRevokedRepo struct {
+	revoked map[string]bool
+}
+
+func (r *fakeRevokedRepo) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	r.revoked[jti] = true
+	return nil
+}
+
+func (r *fakeRevokedRepo) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	return r.revoked[jti], nil
+}
+
+type fakeRefreshRepo struct {
+	domain.RefreshTokenRepository
+	created []*domain.RefreshToken
+}
+
+func (r *fakeRefreshRepo) Create(ctx context.Context, tx *sqlx.Tx, token *domain.RefreshToken) error {
+	r.created = append(r.created, token)
+	return nil
+}
+
+type fakeOutbox struct {
+	outbox.Repository
+	events []*outbox.OutboxEvent
+}
+
+func (r *fakeOutbox) Create(ctx context.Context, tx *sqlx.Tx, event *outbox.OutboxEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+// topics outbox'a yazılan event tiplerini sırayla döner.
+func (r *fakeOutbox) topics() []string {
+	topics := make([]string, 0, len(r.events))
+	for _, event := range r.events {
+		topics = append(topics, event.EventType)
+	}
+	return topics
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/oidc"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+const oidcStateTTL = 10 * time.Minute
+
+// Providers etkin kimlik sağlayıcılarının adlarını yapılandırma sırasıyla döner.
+func (s *authService) Providers() []string {
+	names := make([]string, 0, len(s.authenticators))
+	for _, authenticator := range s.authenticators {
+		names = append(names, authenticator.Name())
+	}
+	return names
+}
+
+// StartOIDC state, nonce ve PKCE verifier üretip saklar ve kullanıcının yönlendirileceği
+// adresi döner.
+func (s *authService) StartOIDC(ctx context.Context) (*domain.OIDCStartResponse, error) {
+	authenticator, err := s.redirectAuthenticator()
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := oidc.RandomString(32)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := oidc.RandomString(32)
+	if err != nil {
+		return nil, err
+	}
+	verifier, challenge, err := oidc.NewPKCE()
+	if err != nil {
+		return nil, err
+	}
+
+	authURL, err := authenticator.AuthCodeURL(ctx, state, nonce, challenge)
+	if err != nil {
+		s.logger.Error("Failed to build authorization url", err, map[string]interface{}{
+			"provider": authenticator.Name(),
+		})
+		return nil, err
+	}
+
+	now := s.now()
+	if err := s.oidcStates.Create(ctx, &domain.OIDCAuthRequest{
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    now.Add(oidcStateTTL),
+		CreatedAt:    now,
+	}); err != nil {
+		s.logger.Error("Failed to store oidc auth request", err, nil)
+		return nil, err
+	}
+
+	return &domain.OIDCStartResponse{AuthorizationURL: authURL, State: state}, nil
+}
+
+// CompleteOIDC callback'te gelen kodu state ile eşleşen verifier ve nonce ile doğrular.
+// State tek kullanımlıktır; başarısız denemeler de state'i tüketir.
+func (s *authService) CompleteOIDC(ctx context.Context, req *domain.OIDCCallbackRequest) (*domain.LoginResponse, error) {
+	authenticator, err := s.redirectAuthenticator()
+	if err != nil {
+		return nil, err
+	}
+
+	authReq, err := s.oidcStates.Consume(ctx, req.State)
+	if err != nil {
+		s.logger.Error("Failed to consume oidc auth request", err, nil)
+		return nil, err
+	}
+	if authReq == nil || s.now().After(authReq.ExpiresAt) {
+		return nil, domain.ErrInvalidOIDCState{}
+	}
+
+	identity, err := authenticator.Authenticate(ctx, domain.Credentials{
+		Code:         req.Code,
+		CodeVerifier: authReq.CodeVerifier,
+		Nonce:        authReq.Nonce,
+	})
+	if err != nil {
+		if _, ok := err.(domain.ErrProviderUnavailable); ok {
+			s.logger.Error("Identity provider unavailable", err, map[string]interface{}{
+				"provider": authenticator.Name(),
+				"ip":       req.IP,
+			})
+		}
+		return nil, err
+	}
+
+	user, err := s.resolveUser(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	return s.finishLogin(ctx, user)
+}
+
+func (s *authService) redirectAuthenticator() (domain.RedirectAuthenticator, error) {
+	for _, authenticator := range s.authenticators {
+		if redirect, ok := authenticator.(domain.RedirectAuthenticator); ok {
+			return redirect, nil
+		}
+	}
+	return nil, domain.ErrUnknownProvider{Provider: "oidc"}
+}
+
+// passwordAuthenticator kullanıcı adı/şifre kabul eden sağlayıcıyı döner; yönlendirmeli
+// sağlayıcılar bu yoldan kullanılamaz.
+func (s *authService) passwordAuthenticator(name string) (domain.Authenticator, error) {
+	if name == "" {
+		name = s.defaultProvider
+	}
+	for _, authenticator := range s.authenticators {
+		if authenticator.Name() != name {
+			continue
+		}
+		if _, ok := authenticator.(domain.RedirectAuthenticator); ok {
+			break
+		}
+		return authenticator, nil
+	}
+	return nil, domain.ErrUnknownProvider{Provider: name}
+}
+
+// resolveUser sağlayıcının doğruladığı kimliği yerel kullanıcıya çevirir. Dış hesaplar
+// (provider, subject) ile eşlenir ve rolleri her girişte sağlayıcıdan senkronlanır; ilk
+// girişte kullanıcı şifresiz oluşturulur. Aynı kullanıcı adında bağlı olmayan bir hesap
+// varsa hesap ele geçirmeye yol açmaması için otomatik bağlanmaz.
+func (s *authService) resolveUser(ctx context.Context, identity *domain.Identity) (*userDomain.User, error) {
+	if identity.User != nil {
+		return identity.User, nil
+	}
+
+	userID, err := s.identities.GetUserID(ctx, identity.Provider, identity.Subject)
+	if err != nil {
+		s.logger.Error("Failed to get linked identity", err, map[string]interface{}{
+			"provider": identity.Provider,
+			"subject":  identity.Subject,
+		})
+		return nil, err
+	}
+
+	if userID != nil {
+		user, err := s.userRepo.GetByUserID(ctx, *userID)
+		if err != nil {
+			s.logger.Error("Failed to get linked user", err, map[string]interface{}{
+				"user_id": userID.String(),
+			})
+			return nil, err
+		}
+
+		// Rol sadece bir eşleme kuralı eşleştiğinde senkronlanır; aksi halde
+		// PUT /users/{id}/role ile yapılan değişiklik her girişte geri alınırdı.
+		if identity.RoleMapped && identity.Role != "" && identity.Role != user.Role {
+			if err := s.userRepo.UpdateRole(ctx, user.Id, identity.Role); err != nil {
+				s.logger.Error("Failed to sync role from identity provider", err, map[string]interface{}{
+					"user_id": user.Id.String(),
+					"role":    identity.Role,
+				})
+				return nil, err
+			}
+			s.logger.Info("User role synced from identity provider", map[string]interface{}{
+				"action":   "USER_ROLE_SYNC",
+				"actor":    identity.Provider + ":" + identity.Subject,
+				"user_id":  user.Id.String(),
+				"provider": identity.Provider,
+				"old_role": user.Role,
+				"new_role": identity.Role,
+			})
+			user.Role = identity.Role
+		}
+
+		if err := s.identities.TouchLogin(ctx, identity.Provider, identity.Subject); err != nil {
+			s.logger.Error("Failed to update identity last login", err, map[string]interface{}{
+				"user_id": user.Id.String(),
+			})
+		}
+		return user, nil
+	}
+
+	if _, err := s.userRepo.GetByUsername(ctx, identity.Username); err == nil {
+		return nil, domain.ErrIdentityConflict{Username: identity.Username}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		s.logger.Error("Failed to get user", err, map[string]interface{}{
+			"username": identity.Username,
+		})
+		return nil, err
+	}
+
+	user := &userDomain.User{
+		Username: identity.Username,
+		Role:     identity.Role,
+		Ad:       identity.Ad,
+		Soyad:    identity.Soyad,
+		Email:    identity.Email,
+	}
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.userRepo.CreateTx(ctx, tx, user); err != nil {
+			return err
+		}
+		return s.identities.Link(ctx, tx, identity.Provider, identity.Subject, user.Id)
+	})
+	if err != nil {
+		if _, ok := err.(userDomain.ErrUsernameTaken); ok {
+			return nil, domain.ErrIdentityConflict{Username: identity.Username}
+		}
+		s.logger.Error("Failed to provision user", err, map[string]interface{}{
+			"provider": identity.Provider,
+			"username": identity.Username,
+		})
+		return nil, err
+	}
+
+	s.logger.Info("User provisioned from identity provider", map[string]interface{}{
+		"action":   "USER_PROVISION",
+		"user_id":  user.Id.String(),
+		"username": user.Username,
+		"provider": identity.Provider,
+		"role":     user.Role,
+	})
+
+	return user, nil
+}
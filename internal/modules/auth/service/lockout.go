@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/events"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/outbox"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	// maxLoginFailures kadar art arda hatalı girişten sonra hesap lockDuration boyunca kilitlenir.
+	maxLoginFailures = 5
+	lockDuration     = 15 * time.Minute
+	// failureWindow içinde yeni hata gelmezse sayaç sıfırdan başlar.
+	failureWindow = 15 * time.Minute
+
+	// Bu kadar hatadan sonra her deneme arasında üstel olarak artan bekleme uygulanır.
+	// IP için eşik yüksektir; NAT arkasındaki kullanıcılar birbirini kilitlemesin.
+	userBackoffAfter = 2
+	ipBackoffAfter   = 10
+	backoffBase      = time.Second
+	backoffMax       = 5 * time.Minute
+)
+
+func userAttemptKey(username string) string {
+	return "user:" + username
+}
+
+func ipAttemptKey(ip string) string {
+	return "ip:" + ip
+}
+
+// backoff hata sayısına göre bir sonraki denemeye kadar beklenecek süreyi döner.
+func backoff(failures, freeFailures int) time.Duration {
+	if failures <= freeFailures {
+		return 0
+	}
+	delay := backoffBase
+	for i := freeFailures + 1; i < failures && delay < backoffMax; i++ {
+		delay *= 2
+	}
+	if delay > backoffMax {
+		delay = backoffMax
+	}
+	return delay
+}
+
+// checkThrottle giriş denemesinden önce hesap kilidini ve kullanıcı/IP backoff'unu kontrol eder.
+func (s *authService) checkThrottle(ctx context.Context, username, ip string, now time.Time) error {
+	checks := []struct {
+		key          string
+		freeFailures int
+	}{
+		{userAttemptKey(username), userBackoffAfter},
+		{ipAttemptKey(ip), ipBackoffAfter},
+	}
+
+	for i, check := range checks {
+		attempt, err := s.attempts.Get(ctx, check.key)
+		if err != nil {
+			s.logger.Error("Failed to get login attempts", err, map[string]interface{}{
+				"key": check.key,
+			})
+			return err
+		}
+		if attempt == nil {
+			continue
+		}
+
+		if i == 0 && attempt.LockedUntil != nil && now.Before(*attempt.LockedUntil) {
+			return domain.ErrAccountLocked{Until: *attempt.LockedUntil}
+		}
+		if now.Sub(attempt.LastFailedAt) > failureWindow {
+			continue
+		}
+		if retryAt := attempt.LastFailedAt.Add(backoff(attempt.Failures, check.freeFailures)); now.Before(retryAt) {
+			return domain.ErrTooManyAttempts{RetryAfter: retryAt.Sub(now)}
+		}
+	}
+	return nil
+}
+
+// recordFailure kullanıcı ve IP sayaçlarını artırır, eşik aşılırsa hesabı kilitler ve
+// LoginFailed/AccountLocked event'lerini outbox'a yazar. user, kullanıcı adı yoksa nil'dir;
+// var olmayan kullanıcı adları da aynı şekilde kilitlenir ki hesap varlığı sızmasın.
+func (s *authService) recordFailure(ctx context.Context, user *userDomain.User, username, ip string, now time.Time) {
+	userAttempt, err := s.attempts.RecordFailure(ctx, userAttemptKey(username), failureWindow)
+	if err != nil {
+		s.logger.Error("Failed to record login failure", err, map[string]interface{}{
+			"username": username,
+		})
+		return
+	}
+	if _, err := s.attempts.RecordFailure(ctx, ipAttemptKey(ip), failureWindow); err != nil {
+		s.logger.Error("Failed to record login failure", err, map[string]interface{}{
+			"ip": ip,
+		})
+	}
+
+	aggregateID := uuid.Nil
+	var userID string
+	if user != nil {
+		aggregateID = user.Id
+		userID = user.Id.String()
+	}
+
+	s.writeOutbox(ctx, nil, aggregateID, events.TopicLoginFailed, events.LoginFailedEvent{
+		UserID:      userID,
+		Username:    username,
+		IP:          ip,
+		Failures:    userAttempt.Failures,
+		AttemptedAt: now,
+	})
+
+	if userAttempt.Failures < maxLoginFailures {
+		return
+	}
+
+	lockedUntil := now.Add(lockDuration)
+	if err := s.attempts.Lock(ctx, userAttemptKey(username), lockedUntil); err != nil {
+		s.logger.Error("Failed to lock account", err, map[string]interface{}{
+			"username": username,
+		})
+		return
+	}
+
+	s.logger.Info("Account locked after failed logins", map[string]interface{}{
+		"action":       "ACCOUNT_LOCK",
+		"username":     username,
+		"ip":           ip,
+		"failures":     userAttempt.Failures,
+		"locked_until": lockedUntil,
+	})
+
+	s.writeOutbox(ctx, nil, aggregateID, events.TopicAccountLocked, events.AccountLockedEvent{
+		UserID:      userID,
+		Username:    username,
+		IP:          ip,
+		Failures:    userAttempt.Failures,
+		LockedUntil: lockedUntil,
+	})
+}
+
+// PruneLoginAttempts failureWindow'u geçmiş ve kilidi bitmiş sayaçları interval aralıklarla
+// siler. Bu sayaçlar artık throttle kararını etkilemez; silinmezse denenen her kullanıcı adı
+// ve IP için bir satır kalıcı olarak birikir.
+func (s *authService) PruneLoginAttempts(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.pruneLoginAttempts(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *authService) pruneLoginAttempts(ctx context.Context) {
+	deleted, err := s.attempts.Prune(ctx, s.now().Add(-failureWindow))
+	if err != nil {
+		s.logger.Error("Failed to prune login attempts", err, nil)
+		return
+	}
+	if deleted > 0 {
+		s.logger.Info("Stale login attempts pruned", map[string]interface{}{
+			"deleted": deleted,
+		})
+	}
+}
+
+// writeOutbox event'i kullanıcı aggregate'i altında outbox'a yazar; tx nil ise ayrı yazılır.
+func (s *authService) writeOutbox(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID, topic string, event any) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		s.logger.Error("Failed to marshal event", err, map[string]interface{}{
+			"event_type": topic,
+		})
+		return err
+	}
+
+	outboxEvent := &outbox.OutboxEvent{
+		AggregateType: "user",
+		AggregateID:   userID,
+		EventType:     topic,
+		Payload:       payload,
+	}
+	if err := s.outboxRepo.Create(ctx, tx, outboxEvent); err != nil {
+		s.logger.Error("Failed to create outbox event", err, map[string]interface{}{
+			"event_type": topic,
+		})
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/events"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/google/uuid"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		failures, free int
+		want           time.Duration
+	}{
+		{0, userBackoffAfter, 0},
+		{2, userBackoffAfter, 0},
+		{3, userBackoffAfter, time.Second},
+		{4, userBackoffAfter, 2 * time.Second},
+		{5, userBackoffAfter, 4 * time.Second},
+		{11, userBackoffAfter, 256 * time.Second},
+		{12, userBackoffAfter, backoffMax},
+		{100, userBackoffAfter, backoffMax},
+		{10, ipBackoffAfter, 0},
+		{11, ipBackoffAfter, time.Second},
+	}
+	for _, tt := range tests {
+		if got := backoff(tt.failures, tt.free); got != tt.want {
+			t.Errorf("backoff(%d, %d) = %v, want %v", tt.failures, tt.free, got, tt.want)
+		}
+	}
+}
+
+// fail hatalı şifreyle giriş yapar; önceki hatanın backoff süresi kadar önce saati ilerletir.
+func (e *testEnv) fail(t *testing.T, username string) {
+	t.Helper()
+	attempt, err := e.attempts.Get(context.Background(), userAttemptKey(username))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempt != nil {
+		e.clock.Advance(backoff(attempt.Failures, userBackoffAfter))
+	}
+
+	_, err = e.svc.Login(context.Background(), &domain.LoginRequest{Username: username, Password: "wrong", IP: "10.0.0.1"})
+	if !errors.As(err, &domain.ErrInvalidCredentials{}) {
+		t.Fatalf("Login() with wrong password error = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func (e *testEnv) failures(t *testing.T, username string) int {
+	t.Helper()
+	attempt, err := e.attempts.Get(context.Background(), userAttemptKey(username))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempt == nil {
+		return 0
+	}
+	return attempt.Failures
+}
+
+func TestLoginBackoffGrows(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	// Her hatadan hemen sonra bir sonraki denemeye kadar beklenecek süre.
+	wantRetryAfter := []time.Duration{0, 0, time.Second, 2 * time.Second}
+	for i, want := range wantRetryAfter {
+		env.fail(t, env.user.Username)
+
+		err := env.svc.checkThrottle(ctx, env.user.Username, "10.0.0.1", env.clock.Now())
+		if want == 0 {
+			if err != nil {
+				t.Fatalf("checkThrottle() after %d failures error = %v, want nil", i+1, err)
+			}
+			continue
+		}
+		var tooMany domain.ErrTooManyAttempts
+		if !errors.As(err, &tooMany) || tooMany.RetryAfter != want {
+			t.Fatalf("checkThrottle() after %d failures error = %v, want retry after %v", i+1, err, want)
+		}
+	}
+
+	// Bekleme süresince doğru şifre de reddedilir, süre dolunca giriş yapılabilir.
+	if _, err := env.login(testPassword); !errors.As(err, &domain.ErrTooManyAttempts{}) {
+		t.Fatalf("Login() during backoff error = %v, want ErrTooManyAttempts", err)
+	}
+	env.clock.Advance(2 * time.Second)
+	if _, err := env.login(testPassword); err != nil {
+		t.Fatalf("Login() after backoff error = %v", err)
+	}
+}
+
+func TestLoginLockoutAtThreshold(t *testing.T) {
+	for _, username := range []string{"ali", "olmayan-kullanici"} {
+		t.Run(username, func(t *testing.T) {
+			env := newTestEnv(t)
+
+			for i := 0; i < maxLoginFailures; i++ {
+				env.fail(t, username)
+			}
+			lockedAt := env.clock.Now()
+
+			_, err := env.svc.Login(context.Background(), &domain.LoginRequest{Username: username, Password: testPassword, IP: "10.0.0.1"})
+			var locked domain.ErrAccountLocked
+			if !errors.As(err, &locked) {
+				t.Fatalf("Login() after %d failures error = %v, want ErrAccountLocked", maxLoginFailures, err)
+			}
+			if want := lockedAt.Add(lockDuration); !locked.Until.Equal(want) {
+				t.Errorf("locked until %v, want %v", locked.Until, want)
+			}
+
+			var failed, lockedEvents int
+			for _, topic := range env.outbox.topics() {
+				switch topic {
+				case events.TopicLoginFailed:
+					failed++
+				case events.TopicAccountLocked:
+					lockedEvents++
+				}
+			}
+			if failed != maxLoginFailures || lockedEvents != 1 {
+				t.Errorf("outbox has %d login_failed and %d account_locked events, want %d and 1", failed, lockedEvents, maxLoginFailures)
+			}
+
+			if username != env.user.Username {
+				return
+			}
+			env.clock.Advance(lockDuration)
+			if _, err := env.login(testPassword); err != nil {
+				t.Fatalf("Login() after lock expired error = %v", err)
+			}
+		})
+	}
+}
+
+func TestSuccessfulLoginResetsCounter(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.fail(t, env.user.Username)
+	env.fail(t, env.user.Username)
+	if _, err := env.login(testPassword); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if got := env.failures(t, env.user.Username); got != 0 {
+		t.Errorf("failures after successful login = %d, want 0", got)
+	}
+}
+
+// 2FA açık kullanıcıda şifreyi bilen biri sayacı sıfırlayıp kod denemeye devam edememeli.
+func TestMFALoginResetsCounterOnlyAfterSecondStep(t *testing.T) {
+	env := newTestEnv(t)
+	secret, _ := env.enableMFA(t)
+
+	env.fail(t, env.user.Username)
+	env.fail(t, env.user.Username)
+	mfaToken := env.challenge(t)
+	if got := env.failures(t, env.user.Username); got != 2 {
+		t.Fatalf("failures after password step = %d, want 2", got)
+	}
+
+	if _, err := env.verify(mfaToken, "000000"); !errors.As(err, &domain.ErrInvalidMFACode{}) {
+		t.Fatalf("VerifyMFA() with wrong code error = %v, want ErrInvalidMFACode", err)
+	}
+	if got := env.failures(t, env.user.Username); got != 3 {
+		t.Fatalf("failures after wrong mfa code = %d, want 3", got)
+	}
+
+	env.clock.Advance(backoff(3, userBackoffAfter))
+	if _, err := env.verify(mfaToken, env.code(t, secret, 0)); err != nil {
+		t.Fatalf("VerifyMFA() error = %v", err)
+	}
+	if got := env.failures(t, env.user.Username); got != 0 {
+		t.Errorf("failures after second step = %d, want 0", got)
+	}
+}
+
+func TestUnlockUser(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	for i := 0; i < maxLoginFailures; i++ {
+		env.fail(t, env.user.Username)
+	}
+	if _, err := env.login(testPassword); !errors.As(err, &domain.ErrAccountLocked{}) {
+		t.Fatalf("Login() error = %v, want ErrAccountLocked", err)
+	}
+
+	if err := env.svc.UnlockUser(ctx, env.user.Id); err != nil {
+		t.Fatalf("UnlockUser() error = %v", err)
+	}
+	if _, err := env.login(testPassword); err != nil {
+		t.Fatalf("Login() after unlock error = %v", err)
+	}
+
+	if err := env.svc.UnlockUser(ctx, uuid.New()); !errors.As(err, &userDomain.ErrUserNotFound{}) {
+		t.Errorf("UnlockUser() for unknown user error = %v, want ErrUserNotFound", err)
+	}
+}
+
+func TestFailureWindowRestartsCounter(t *testing.T) {
+	env := newTestEnv(t)
+
+	for i := 0; i < maxLoginFailures-1; i++ {
+		env.fail(t, env.user.Username)
+	}
+	env.clock.Advance(failureWindow + time.Second)
+
+	// Eski hatalar backoff uygulamaz ve sayaç 1'den başlar.
+	env.fail(t, env.user.Username)
+	if got := env.failures(t, env.user.Username); got != 1 {
+		t.Errorf("failures after window = %d, want 1", got)
+	}
+}
+
+func TestIPBackoff(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	// Her deneme farklı kullanıcı adıyla; sadece IP sayacı birikir.
+	for i := 0; i < ipBackoffAfter+1; i++ {
+		env.fail(t, fmt.Sprintf("user-%d", i))
+	}
+
+	_, err := env.svc.Login(ctx, &domain.LoginRequest{Username: env.user.Username, Password: testPassword, IP: "10.0.0.1"})
+	var tooMany domain.ErrTooManyAttempts
+	if !errors.As(err, &tooMany) || tooMany.RetryAfter != time.Second {
+		t.Fatalf("Login() from throttled IP error = %v, want retry after 1s", err)
+	}
+
+	if _, err := env.svc.Login(ctx, &domain.LoginRequest{Username: env.user.Username, Password: testPassword, IP: "10.0.0.2"}); err != nil {
+		t.Fatalf("Login() from another IP error = %v", err)
+	}
+}
+
+func TestPruneLoginAttempts(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"user:stale", "user:locked"} {
+		if _, err := env.attempts.RecordFailure(ctx, key, failureWindow); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := env.attempts.Lock(ctx, "user:locked", env.clock.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	env.clock.Advance(failureWindow + time.Minute)
+	if _, err := env.attempts.RecordFailure(ctx, "user:fresh", failureWindow); err != nil {
+		t.Fatal(err)
+	}
+
+	env.svc.pruneLoginAttempts(ctx)
+
+	for key, wantKept := range map[string]bool{"user:stale": false, "user:locked": true, "user:fresh": true} {
+		attempt, err := env.attempts.Get(ctx, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if kept := attempt != nil; kept != wantKept {
+			t.Errorf("%s kept = %v, want %v", key, kept, wantKept)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/token"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/totp"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	mfaTokenTTL = 5 * time.Minute
+	// mfaSkew kadar önceki/sonraki adımın kodu da kabul edilir (saat kayması için ±30 sn).
+	mfaSkew           = 1
+	recoveryCodeCount = 10
+	defaultMFAIssuer  = "Go Modular Monolith"
+)
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// EnrollMFA yeni bir TOTP secret'ı üretir. Kayıt ActivateMFA ile geçerli bir kod
+// doğrulanana kadar girişi etkilemez; tekrar çağrılırsa önceki secret geçersiz olur.
+func (s *authService) EnrollMFA(ctx context.Context, userID uuid.UUID) (*domain.MFAEnrollResponse, error) {
+	user, err := s.userRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, userDomain.ErrUserNotFound{}
+		}
+		s.logger.Error("Failed to get user", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return nil, err
+	}
+
+	existing, err := s.mfaRepo.Get(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get mfa settings", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return nil, err
+	}
+	if existing.Enabled() {
+		return nil, domain.ErrMFAAlreadyEnabled{}
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mfaRepo.SavePending(ctx, userID, secret); err != nil {
+		s.logger.Error("Failed to save mfa secret", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return nil, err
+	}
+
+	s.logger.Info("MFA enrollment started", map[string]interface{}{
+		"action":  "MFA_ENROLL",
+		"user_id": userID.String(),
+	})
+
+	return &domain.MFAEnrollResponse{
+		Secret:     secret,
+		OTPAuthURI: totp.URI(mfaIssuer(), user.Username, secret),
+	}, nil
+}
+
+// ActivateMFA ilk kodu doğrulayarak 2FA'yı açar ve kurtarma kodlarını üretir.
+func (s *authService) ActivateMFA(ctx context.Context, userID uuid.UUID, req *domain.MFAActivateRequest) (*domain.MFAActivateResponse, error) {
+	mfa, err := s.mfaRepo.Get(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get mfa settings", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return nil, err
+	}
+	if mfa == nil {
+		return nil, domain.ErrMFANotEnrolled{}
+	}
+	if mfa.Enabled() {
+		return nil, domain.ErrMFAAlreadyEnabled{}
+	}
+
+	step, ok := totp.Validate(mfa.Secret, req.Code, s.now(), mfaSkew)
+	if !ok {
+		return nil, domain.ErrInvalidMFACode{}
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.mfaRepo.Enable(ctx, tx, userID, step); err != nil {
+			return err
+		}
+		return s.mfaRepo.ReplaceRecoveryCodes(ctx, tx, userID, hashes)
+	})
+	if err != nil {
+		if _, ok := err.(domain.ErrMFANotEnrolled); !ok {
+			s.logger.Error("Failed to enable mfa", err, map[string]interface{}{
+				"user_id": userID.String(),
+			})
+		}
+		return nil, err
+	}
+
+	s.logger.Info("MFA enabled", map[string]interface{}{
+		"action":  "MFA_ENABLE",
+		"user_id": userID.String(),
+	})
+
+	return &domain.MFAActivateResponse{RecoveryCodes: codes}, nil
+}
+
+// DisableMFA kullanıcının kendi 2FA kaydını şifresini doğrulayarak kaldırır.
+func (s *authService) DisableMFA(ctx context.Context, userID uuid.UUID, req *domain.MFADisableRequest) error {
+	user, err := s.getUserWithPassword(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return domain.ErrWrongPassword{}
+	}
+
+	return s.deleteMFA(ctx, userID, "MFA_DISABLE")
+}
+
+// ResetMFA cihazını ve kurtarma kodlarını kaybeden kullanıcı için admin tarafından çağrılır.
+func (s *authService) ResetMFA(ctx context.Context, userID uuid.UUID) error {
+	if err := s.userGuard.CheckManageable(ctx, userID); err != nil {
+		return err
+	}
+
+	return s.deleteMFA(ctx, userID, "MFA_RESET")
+}
+
+func (s *authService) deleteMFA(ctx context.Context, userID uuid.UUID, action string) error {
+	mfa, err := s.mfaRepo.Get(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get mfa settings", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return err
+	}
+	if mfa == nil {
+		return domain.ErrMFANotEnrolled{}
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		return s.mfaRepo.Delete(ctx, tx, userID)
+	})
+	if err != nil {
+		s.logger.Error("Failed to delete mfa settings", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return err
+	}
+
+	s.logger.Info("MFA disabled", map[string]interface{}{
+		"action":  action,
+		"user_id": userID.String(),
+	})
+
+	return nil
+}
+
+// VerifyMFA mfa_pending token'ını geçerli bir TOTP veya kurtarma koduyla access token'a
+// çevirir. Hatalı kodlar şifre hataları gibi sayılır ve hesabı kilitleyebilir.
+func (s *authService) VerifyMFA(ctx context.Context, req *domain.MFAVerifyRequest) (*domain.LoginResponse, error) {
+	claims, err := s.tokens.Parse(req.MFAToken)
+	if err != nil || claims.Purpose != token.PurposeMFAPending || claims.ID == "" {
+		return nil, domain.ErrInvalidMFAToken{}
+	}
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return nil, domain.ErrInvalidMFAToken{}
+	}
+
+	revoked, err := s.revokedRepo.IsRevoked(ctx, claims.ID)
+	if err != nil {
+		s.logger.Error("Failed to check mfa token", err, nil)
+		return nil, err
+	}
+	if revoked {
+		return nil, domain.ErrInvalidMFAToken{}
+	}
+
+	now := s.now()
+	if err := s.checkThrottle(ctx, claims.Username, req.IP, now); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrInvalidMFAToken{}
+		}
+		s.logger.Error("Failed to get user", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return nil, err
+	}
+	if err := checkUserStatus(user); err != nil {
+		return nil, err
+	}
+	mfa, err := s.mfaRepo.Get(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get mfa settings", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return nil, err
+	}
+	if !mfa.Enabled() {
+		return nil, domain.ErrInvalidMFAToken{}
+	}
+
+	ok, err := s.checkMFACode(ctx, mfa, req.Code, now)
+	if err != nil {
+		s.logger.Error("Failed to verify mfa code", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return nil, err
+	}
+	if !ok {
+		s.logger.Error("MFA kodu doğrulanamadı", domain.ErrInvalidMFACode{}, map[string]interface{}{"username": user.Username, "ip": req.IP})
+		s.recordFailure(ctx, user, user.Username, req.IP, now)
+		return nil, domain.ErrInvalidMFACode{}
+	}
+
+	// mfa_pending token'ı tek kullanımlıktır.
+	if err := s.revokedRepo.Revoke(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+		s.logger.Error("Failed to revoke mfa token", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return nil, err
+	}
+
+	return s.completeLogin(ctx, user)
+}
+
+// checkMFACode 6 haneli kodları TOTP, diğerlerini kurtarma kodu olarak dener.
+func (s *authService) checkMFACode(ctx context.Context, mfa *domain.UserMFA, code string, now time.Time) (bool, error) {
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		step, ok := totp.Validate(mfa.Secret, code, now, mfaSkew)
+		if !ok {
+			return false, nil
+		}
+		return s.mfaRepo.ConsumeStep(ctx, mfa.UserID, step)
+	}
+
+	used, err := s.mfaRepo.UseRecoveryCode(ctx, mfa.UserID, hashToken(normalizeRecoveryCode(code)))
+	if err != nil || !used {
+		return false, err
+	}
+
+	s.logger.Info("MFA recovery code used", map[string]interface{}{
+		"action":  "MFA_RECOVERY_CODE_USE",
+		"user_id": mfa.UserID.String(),
+	})
+	return true, nil
+}
+
+func (s *authService) issueMFAToken(user *userDomain.User) (*domain.MFAChallenge, error) {
+	now := s.now()
+	expiresAt := now.Add(mfaTokenTTL)
+	claims := &token.Claims{
+		UserID:   user.Id.String(),
+		Username: user.Username,
+		Purpose:  token.PurposeMFAPending,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	tokenString, err := s.tokens.Sign(claims)
+	if err != nil {
+		s.logger.Error("MFA token oluşturulamadı", err, nil)
+		return nil, domain.ErrTokenGeneration{}
+	}
+
+	return &domain.MFAChallenge{
+		MFARequired:       true,
+		MFAToken:          tokenString,
+		MFATokenExpiresAt: expiresAt,
+	}, nil
+}
+
+// generateRecoveryCodes "xxxxx-xxxxx" biçiminde kodlar ve saklanacak hash'lerini üretir.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		buf := make([]byte, 7)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(recoveryEncoding.EncodeToString(buf))[:10]
+		codes = append(codes, raw[:5]+"-"+raw[5:])
+		hashes = append(hashes, hashToken(raw))
+	}
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+func mfaIssuer() string {
+	if issuer := os.Getenv("MFA_ISSUER"); issuer != "" {
+		return issuer
+	}
+	return defaultMFAIssuer
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/token"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/totp"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// enableMFA 2FA'yı testNow'dan bir saat önce açar ki aktivasyon adımı testlerdeki kodlarla
+// çakışmasın; secret ve kurtarma kodlarını döner.
+func (e *testEnv) enableMFA(t *testing.T) (string, []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	e.clock.Advance(-time.Hour)
+	defer e.clock.Advance(time.Hour)
+
+	enrolled, err := e.svc.EnrollMFA(ctx, e.user.Id)
+	if err != nil {
+		t.Fatalf("EnrollMFA() error = %v", err)
+	}
+	activated, err := e.svc.ActivateMFA(ctx, e.user.Id, &domain.MFAActivateRequest{Code: e.code(t, enrolled.Secret, 0)})
+	if err != nil {
+		t.Fatalf("ActivateMFA() error = %v", err)
+	}
+	return enrolled.Secret, activated.RecoveryCodes
+}
+
+// code saatin offset adım ötesindeki TOTP kodudur.
+func (e *testEnv) code(t *testing.T, secret string, offset int64) string {
+	t.Helper()
+	code, err := totp.Code(secret, totp.Step(e.clock.Now())+offset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+// challenge şifreyle giriş yapar ve dönen mfa_pending token'ını döner.
+func (e *testEnv) challenge(t *testing.T) string {
+	t.Helper()
+	_, err := e.login(testPassword)
+	var required domain.ErrMFARequired
+	if !errors.As(err, &required) {
+		t.Fatalf("Login() error = %v, want ErrMFARequired", err)
+	}
+	return required.Challenge.MFAToken
+}
+
+func (e *testEnv) verify(mfaToken, code string) (*domain.LoginResponse, error) {
+	return e.svc.VerifyMFA(context.Background(), &domain.MFAVerifyRequest{MFAToken: mfaToken, Code: code, IP: "10.0.0.1"})
+}
+
+func TestEnrollAndActivateMFA(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	if _, err := env.svc.ActivateMFA(ctx, env.user.Id, &domain.MFAActivateRequest{Code: "123456"}); !errors.As(err, &domain.ErrMFANotEnrolled{}) {
+		t.Fatalf("ActivateMFA() before enroll error = %v, want ErrMFANotEnrolled", err)
+	}
+
+	enrolled, err := env.svc.EnrollMFA(ctx, env.user.Id)
+	if err != nil {
+		t.Fatalf("EnrollMFA() error = %v", err)
+	}
+	if !strings.HasPrefix(enrolled.OTPAuthURI, "otpauth://totp/") || !strings.Contains(enrolled.OTPAuthURI, "secret="+enrolled.Secret) {
+		t.Errorf("OTPAuthURI = %q", enrolled.OTPAuthURI)
+	}
+
+	// Doğrulanmamış kayıt girişi etkilemez.
+	if _, err := env.login(testPassword); err != nil {
+		t.Fatalf("Login() with pending enrollment error = %v", err)
+	}
+
+	// Tekrar kayıt önceki secret'ı geçersiz kılar.
+	reenrolled, err := env.svc.EnrollMFA(ctx, env.user.Id)
+	if err != nil {
+		t.Fatalf("EnrollMFA() again error = %v", err)
+	}
+	if _, err := env.svc.ActivateMFA(ctx, env.user.Id, &domain.MFAActivateRequest{Code: env.code(t, enrolled.Secret, 0)}); !errors.As(err, &domain.ErrInvalidMFACode{}) {
+		t.Fatalf("ActivateMFA() with replaced secret error = %v, want ErrInvalidMFACode", err)
+	}
+
+	activated, err := env.svc.ActivateMFA(ctx, env.user.Id, &domain.MFAActivateRequest{Code: env.code(t, reenrolled.Secret, 0)})
+	if err != nil {
+		t.Fatalf("ActivateMFA() error = %v", err)
+	}
+	if len(activated.RecoveryCodes) != recoveryCodeCount {
+		t.Errorf("got %d recovery codes, want %d", len(activated.RecoveryCodes), recoveryCodeCount)
+	}
+
+	if _, err := env.svc.EnrollMFA(ctx, env.user.Id); !errors.As(err, &domain.ErrMFAAlreadyEnabled{}) {
+		t.Errorf("EnrollMFA() after activation error = %v, want ErrMFAAlreadyEnabled", err)
+	}
+	if _, err := env.svc.ActivateMFA(ctx, env.user.Id, &domain.MFAActivateRequest{Code: env.code(t, reenrolled.Secret, 1)}); !errors.As(err, &domain.ErrMFAAlreadyEnabled{}) {
+		t.Errorf("ActivateMFA() after activation error = %v, want ErrMFAAlreadyEnabled", err)
+	}
+
+	// Aktivasyondan sonra şifre tek başına yetmez.
+	env.challenge(t)
+}
+
+func TestVerifyMFAClockDrift(t *testing.T) {
+	tests := []struct {
+		name   string
+		offset int64
+		ok     bool
+	}{
+		{"iki adım geride", -2, false},
+		{"bir adım geride", -1, true},
+		{"aynı adım", 0, true},
+		{"bir adım ileride", 1, true},
+		{"iki adım ileride", 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			secret, _ := env.enableMFA(t)
+
+			resp, err := env.verify(env.challenge(t), env.code(t, secret, tt.offset))
+			if !tt.ok {
+				if !errors.As(err, &domain.ErrInvalidMFACode{}) {
+					t.Fatalf("VerifyMFA() error = %v, want ErrInvalidMFACode", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyMFA() error = %v", err)
+			}
+			if resp.Token == "" || resp.RefreshToken == "" {
+				t.Errorf("VerifyMFA() response = %+v, want access and refresh tokens", resp)
+			}
+		})
+	}
+}
+
+func TestVerifyMFARejectsReplay(t *testing.T) {
+	tests := []struct {
+		name         string
+		first, again int64
+	}{
+		{"aynı kod", 0, 0},
+		{"kullanılmış adımdan eski kod", 1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			secret, _ := env.enableMFA(t)
+
+			if _, err := env.verify(env.challenge(t), env.code(t, secret, tt.first)); err != nil {
+				t.Fatalf("first VerifyMFA() error = %v", err)
+			}
+			if _, err := env.verify(env.challenge(t), env.code(t, secret, tt.again)); !errors.As(err, &domain.ErrInvalidMFACode{}) {
+				t.Fatalf("replayed VerifyMFA() error = %v, want ErrInvalidMFACode", err)
+			}
+		})
+	}
+}
+
+func TestRecoveryCodeWorksOnce(t *testing.T) {
+	env := newTestEnv(t)
+	_, codes := env.enableMFA(t)
+
+	// Kodlar büyük harf ve boşlukla girilse de kabul edilir.
+	code := " " + strings.ToUpper(codes[0]) + " "
+	if _, err := env.verify(env.challenge(t), code); err != nil {
+		t.Fatalf("VerifyMFA() with recovery code error = %v", err)
+	}
+	if _, err := env.verify(env.challenge(t), codes[0]); !errors.As(err, &domain.ErrInvalidMFACode{}) {
+		t.Fatalf("VerifyMFA() with used recovery code error = %v, want ErrInvalidMFACode", err)
+	}
+	if _, err := env.verify(env.challenge(t), codes[1]); err != nil {
+		t.Fatalf("VerifyMFA() with another recovery code error = %v", err)
+	}
+}
+
+func TestMFATokenExchange(t *testing.T) {
+	tests := []struct {
+		name string
+		// mfaToken doğrulanacak token'ı üretir; ok false ise ErrInvalidMFAToken beklenir.
+		mfaToken func(t *testing.T, env *testEnv) string
+		ok       bool
+	}{
+		{
+			name:     "geçerli token",
+			mfaToken: func(t *testing.T, env *testEnv) string { return env.challenge(t) },
+			ok:       true,
+		},
+		{
+			name: "süresi dolmadan hemen önce",
+			mfaToken: func(t *testing.T, env *testEnv) string {
+				mfaToken := env.challenge(t)
+				env.clock.Advance(mfaTokenTTL - time.Second)
+				return mfaToken
+			},
+			ok: true,
+		},
+		{
+			name: "süresi dolmuş token",
+			mfaToken: func(t *testing.T, env *testEnv) string {
+				mfaToken := env.challenge(t)
+				env.clock.Advance(mfaTokenTTL + time.Second)
+				return mfaToken
+			},
+		},
+		{
+			name: "kullanılmış token",
+			mfaToken: func(t *testing.T, env *testEnv) string {
+				mfaToken := env.challenge(t)
+				if _, err := env.verify(mfaToken, env.code(t, env.mfa.settings[env.user.Id].Secret, 0)); err != nil {
+					t.Fatalf("first VerifyMFA() error = %v", err)
+				}
+				env.clock.Advance(totp.Period * time.Second)
+				return mfaToken
+			},
+		},
+		{
+			name: "access token mfa token yerine kullanılamaz",
+			mfaToken: func(t *testing.T, env *testEnv) string {
+				resp, err := env.svc.issueAccessToken(env.user)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return resp.Token
+			},
+		},
+		{
+			name: "başka amaçlı token",
+			mfaToken: func(t *testing.T, env *testEnv) string {
+				signed, err := env.svc.tokens.Sign(&token.Claims{
+					UserID:  env.user.Id.String(),
+					Purpose: "password_reset",
+					RegisteredClaims: jwt.RegisteredClaims{
+						ID:        "other-purpose",
+						ExpiresAt: jwt.NewNumericDate(env.clock.Now().Add(time.Minute)),
+					},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				return signed
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			secret, _ := env.enableMFA(t)
+
+			mfaToken := tt.mfaToken(t, env)
+			_, err := env.verify(mfaToken, env.code(t, secret, 0))
+			if !tt.ok {
+				if !errors.As(err, &domain.ErrInvalidMFAToken{}) {
+					t.Fatalf("VerifyMFA() error = %v, want ErrInvalidMFAToken", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyMFA() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/events"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const passwordResetTTL = time.Hour
+
+// ChangePassword mevcut şifreyi doğrulayıp yenisini kaydeder. Kullanıcının diğer
+// oturumları (refresh token'ları) ve bekleyen sıfırlama token'ları iptal edilir.
+func (s *authService) ChangePassword(ctx context.Context, userID uuid.UUID, req *domain.ChangePasswordRequest) error {
+	user, err := s.getUserWithPassword(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		return domain.ErrWrongPassword{}
+	}
+	if req.NewPassword == req.CurrentPassword {
+		return domain.ErrPasswordUnchanged{}
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), 14)
+	if err != nil {
+		s.logger.Error("Failed to hash password", err, nil)
+		return err
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.userRepo.UpdatePassword(ctx, tx, user.Id, string(hashedPassword)); err != nil {
+			return err
+		}
+		return s.resetRepo.InvalidateForUser(ctx, tx, user.Id)
+	})
+	if err != nil {
+		s.logger.Error("Failed to change password", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return err
+	}
+
+	if err := s.refreshRepo.RevokeByUser(ctx, user.Id); err != nil {
+		s.logger.Error("Failed to revoke sessions after password change", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+	}
+
+	s.logger.Info("Password changed", map[string]interface{}{
+		"action":  "PASSWORD_CHANGE",
+		"user_id": userID.String(),
+	})
+
+	return nil
+}
+
+// RequestPasswordReset kullanıcı için tek kullanımlık bir sıfırlama token'ı üretir ve
+// PasswordResetRequested event'i ile bildirim modülüne iletir. Kullanıcı adının var olup
+// olmadığı çağırana hiçbir zaman belli edilmez.
+func (s *authService) RequestPasswordReset(ctx context.Context, req *domain.ForgotPasswordRequest) error {
+	user, err := s.userRepo.GetByUsername(ctx, req.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			s.logger.Info("Password reset requested for unknown user", map[string]interface{}{
+				"action":   "PASSWORD_RESET_REQUEST",
+				"username": req.Username,
+			})
+			return nil
+		}
+		s.logger.Error("Failed to get user", err, map[string]interface{}{
+			"username": req.Username,
+		})
+		return err
+	}
+	if user.Email == "" {
+		s.logger.Info("Password reset requested for user without email", map[string]interface{}{
+			"action":   "PASSWORD_RESET_REQUEST",
+			"username": req.Username,
+		})
+		return nil
+	}
+	// Dış sağlayıcıdan oluşturulan hesapların yerel şifresi yoktur; sıfırlama ile şifre
+	// edinmeleri sağlayıcıyı devre dışı bırakmak olurdu.
+	if user.Password == "" {
+		s.logger.Info("Password reset requested for externally managed user", map[string]interface{}{
+			"action":   "PASSWORD_RESET_REQUEST",
+			"username": req.Username,
+		})
+		return nil
+	}
+	if !user.IsActive() {
+		s.logger.Info("Password reset requested for inactive user", map[string]interface{}{
+			"action":   "PASSWORD_RESET_REQUEST",
+			"username": req.Username,
+			"status":   user.Status,
+		})
+		return nil
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return err
+	}
+	raw := base64.RawURLEncoding.EncodeToString(buf)
+
+	now := s.now()
+	token := &domain.PasswordResetToken{
+		ID:        uuid.New(),
+		UserID:    user.Id,
+		TokenHash: hashToken(raw),
+		ExpiresAt: now.Add(passwordResetTTL),
+		CreatedAt: now,
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.resetRepo.InvalidateForUser(ctx, tx, user.Id); err != nil {
+			return err
+		}
+		if err := s.resetRepo.Create(ctx, tx, token); err != nil {
+			return err
+		}
+		return s.writeOutbox(ctx, tx, user.Id, events.TopicPasswordReset, events.PasswordResetRequestedEvent{
+			UserID:    user.Id.String(),
+			Username:  user.Username,
+			UserEmail: user.Email,
+			Token:     raw,
+			ExpiresAt: token.ExpiresAt,
+		})
+	})
+	if err != nil {
+		s.logger.Error("Failed to create password reset token", err, map[string]interface{}{
+			"user_id": user.Id.String(),
+		})
+		return err
+	}
+
+	s.logger.Info("Password reset requested", map[string]interface{}{
+		"action":  "PASSWORD_RESET_REQUEST",
+		"user_id": user.Id.String(),
+	})
+
+	return nil
+}
+
+// ResetPassword token'ı tüketip yeni şifreyi kaydeder; tüm oturumlar sonlandırılır ve
+// hesap kilidi kaldırılır.
+func (s *authService) ResetPassword(ctx context.Context, req *domain.ResetPasswordRequest) error {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), 14)
+	if err != nil {
+		s.logger.Error("Failed to hash password", err, nil)
+		return err
+	}
+
+	var userID uuid.UUID
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		token, err := s.resetRepo.GetByHashForUpdate(ctx, tx, hashToken(req.Token))
+		if err != nil {
+			return err
+		}
+		if token == nil || token.UsedAt != nil || s.now().After(token.ExpiresAt) {
+			return domain.ErrInvalidResetToken{}
+		}
+		userID = token.UserID
+
+		if err := s.resetRepo.MarkUsed(ctx, tx, token.ID); err != nil {
+			return err
+		}
+		return s.userRepo.UpdatePassword(ctx, tx, token.UserID, string(hashedPassword))
+	})
+	if err != nil {
+		if _, ok := err.(domain.ErrInvalidResetToken); !ok {
+			s.logger.Error("Failed to reset password", err, nil)
+		}
+		return err
+	}
+
+	if err := s.refreshRepo.RevokeByUser(ctx, userID); err != nil {
+		s.logger.Error("Failed to revoke sessions after password reset", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+	}
+	if user, err := s.userRepo.GetByUserID(ctx, userID); err == nil {
+		if err := s.attempts.Reset(ctx, userAttemptKey(user.Username)); err != nil {
+			s.logger.Error("Failed to reset login attempts", err, map[string]interface{}{
+				"user_id": userID.String(),
+			})
+		}
+	}
+
+	s.logger.Info("Password reset completed", map[string]interface{}{
+		"action":  "PASSWORD_RESET",
+		"user_id": userID.String(),
+	})
+
+	return nil
+}
+
+// getUserWithPassword GetByUserID şifre hash'ini taşımadığı için kullanıcıyı
+// username üzerinden tekrar okur.
+func (s *authService) getUserWithPassword(ctx context.Context, userID uuid.UUID) (*userDomain.User, error) {
+	user, err := s.userRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, userDomain.ErrUserNotFound{}
+		}
+		return nil, err
+	}
+	return s.userRepo.GetByUsername(ctx, user.Username)
+}
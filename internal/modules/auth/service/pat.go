@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/middleware"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/token"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	defaultPATLifetime = 90 * 24 * time.Hour
+	// patPrefixLength listelemede gösterilen, "pat_" dahil token başlangıcıdır.
+	patPrefixLength = 12
+)
+
+type PATService interface {
+	Create(ctx context.Context, userID uuid.UUID, req *domain.CreatePATRequest) (*domain.CreatePATResponse, error)
+	List(ctx context.Context, userID uuid.UUID) ([]domain.PersonalAccessToken, error)
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+	AuthenticatePAT(ctx context.Context, raw string) (*middleware.PATIdentity, error)
+}
+
+type patService struct {
+	repo        domain.PATRepository
+	userRepo    userDomain.UserRepository
+	permissions domain.PermissionChecker
+	logger      logger.Logger
+	now         func() time.Time
+}
+
+func NewPATService(repo domain.PATRepository, userRepo userDomain.UserRepository, permissions domain.PermissionChecker, logger logger.Logger) PATService {
+	return &patService{
+		repo:        repo,
+		userRepo:    userRepo,
+		permissions: permissions,
+		logger:      logger,
+		now:         time.Now,
+	}
+}
+
+// Create token'a sadece kullanıcının rolünün verdiği yetkileri tanımlar. Ham token
+// sadece bu yanıtta döner.
+func (s *patService) Create(ctx context.Context, userID uuid.UUID, req *domain.CreatePATRequest) (*domain.CreatePATResponse, error) {
+	user, err := s.userRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, userDomain.ErrUserNotFound{}
+		}
+		s.logger.Error("Failed to get user", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return nil, err
+	}
+
+	permissions := make([]string, 0, len(req.Permissions))
+	seen := make(map[string]bool, len(req.Permissions))
+	for _, permission := range req.Permissions {
+		if seen[permission] {
+			continue
+		}
+		seen[permission] = true
+
+		allowed, err := s.permissions.HasPermission(ctx, user.Role, permission)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, domain.ErrPermissionNotGranted{Permission: permission}
+		}
+		permissions = append(permissions, permission)
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	raw := token.PATPrefix + base64.RawURLEncoding.EncodeToString(buf)
+
+	lifetime := defaultPATLifetime
+	if req.ExpiresInDays > 0 {
+		lifetime = time.Duration(req.ExpiresInDays) * 24 * time.Hour
+	}
+
+	now := s.now()
+	pat := domain.PersonalAccessToken{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Name:        req.Name,
+		TokenHash:   hashToken(raw),
+		Prefix:      raw[:patPrefixLength],
+		Permissions: pq.StringArray(permissions),
+		ExpiresAt:   now.Add(lifetime),
+		CreatedAt:   now,
+	}
+	if err := s.repo.Create(ctx, &pat); err != nil {
+		if _, ok := err.(domain.ErrPATNameTaken); !ok {
+			s.logger.Error("Failed to create personal access token", err, map[string]interface{}{
+				"user_id": userID.String(),
+			})
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Personal access token created", map[string]interface{}{
+		"action":      "PAT_CREATE",
+		"user_id":     userID.String(),
+		"token_id":    pat.ID.String(),
+		"permissions": permissions,
+		"expires_at":  pat.ExpiresAt,
+	})
+
+	return &domain.CreatePATResponse{Token: raw, PersonalAccessToken: pat}, nil
+}
+
+func (s *patService) List(ctx context.Context, userID uuid.UUID) ([]domain.PersonalAccessToken, error) {
+	tokens, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to list personal access tokens", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (s *patService) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	if err := s.repo.Delete(ctx, userID, id); err != nil {
+		if _, ok := err.(domain.ErrPATNotFound); !ok {
+			s.logger.Error("Failed to delete personal access token", err, map[string]interface{}{
+				"user_id":  userID.String(),
+				"token_id": id.String(),
+			})
+		}
+		return err
+	}
+
+	s.logger.Info("Personal access token deleted", map[string]interface{}{
+		"action":   "PAT_DELETE",
+		"user_id":  userID.String(),
+		"token_id": id.String(),
+	})
+
+	return nil
+}
+
+// AuthenticatePAT token'ı sahibinin güncel rolüyle döner. Rolün artık vermediği yetkiler
+// RequirePermission'daki rol kontrolüne takılır; token yetkisini rolünden fazla kullanamaz.
+func (s *patService) AuthenticatePAT(ctx context.Context, raw string) (*middleware.PATIdentity, error) {
+	pat, err := s.repo.GetByHash(ctx, hashToken(raw))
+	if err != nil {
+		s.logger.Error("Failed to get personal access token", err, nil)
+		return nil, err
+	}
+	if pat == nil || s.now().After(pat.ExpiresAt) {
+		return nil, nil
+	}
+
+	user, err := s.userRepo.GetByUserID(ctx, pat.UserID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		s.logger.Error("Failed to get token owner", err, map[string]interface{}{
+			"token_id": pat.ID.String(),
+		})
+		return nil, err
+	}
+	if !user.IsActive() {
+		return nil, nil
+	}
+
+	if err := s.repo.TouchLastUsed(ctx, pat.ID); err != nil {
+		s.logger.Error("Failed to update token last used", err, map[string]interface{}{
+			"token_id": pat.ID.String(),
+		})
+	}
+
+	return &middleware.PATIdentity{
+		UserID:      user.Id.String(),
+		Username:    user.Username,
+		Role:        user.Role,
+		Permissions: []string(pat.Permissions),
+	}, nil
+}
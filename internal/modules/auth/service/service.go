@@ -1,129 +1,456 @@
 package service
 
 import (
-	"log"
-	"os"
-	"sync"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
 	"time"
 
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/database"
 	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/outbox"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/token"
 	"github.com/M1ralai/go-modular-monolith-template/internal/modules/auth/domain"
 	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
 	"github.com/golang-jwt/jwt/v5"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 )
 
-var (
-	jwtKey  []byte
-	jwtOnce sync.Once
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 30 * 24 * time.Hour
 )
 
-func getJWTKey() []byte {
-	jwtOnce.Do(func() {
-		key := os.Getenv("JWT_SECRET")
-		if key == "" {
-			log.Fatal("JWT_SECRET environment variable is not set")
-		}
-		jwtKey = []byte(key)
-	})
-	return jwtKey
-}
-
 type AuthService interface {
-	Login(req *domain.LoginRequest) (*domain.LoginResponse, error)
+	Login(ctx context.Context, req *domain.LoginRequest) (*domain.LoginResponse, error)
+	Refresh(ctx context.Context, req *domain.RefreshRequest) (*domain.LoginResponse, error)
+	Logout(ctx context.Context, jti string, expiresAt time.Time, req *domain.LogoutRequest) error
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID) error
+	UnlockUser(ctx context.Context, userID uuid.UUID) error
+	PruneLoginAttempts(ctx context.Context, interval time.Duration)
+	ChangePassword(ctx context.Context, userID uuid.UUID, req *domain.ChangePasswordRequest) error
+	RequestPasswordReset(ctx context.Context, req *domain.ForgotPasswordRequest) error
+	ResetPassword(ctx context.Context, req *domain.ResetPasswordRequest) error
+	EnrollMFA(ctx context.Context, userID uuid.UUID) (*domain.MFAEnrollResponse, error)
+	ActivateMFA(ctx context.Context, userID uuid.UUID, req *domain.MFAActivateRequest) (*domain.MFAActivateResponse, error)
+	DisableMFA(ctx context.Context, userID uuid.UUID, req *domain.MFADisableRequest) error
+	ResetMFA(ctx context.Context, userID uuid.UUID) error
+	VerifyMFA(ctx context.Context, req *domain.MFAVerifyRequest) (*domain.LoginResponse, error)
+	StartOIDC(ctx context.Context) (*domain.OIDCStartResponse, error)
+	CompleteOIDC(ctx context.Context, req *domain.OIDCCallbackRequest) (*domain.LoginResponse, error)
+	Providers() []string
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+	IsUserActive(ctx context.Context, userID string) (bool, error)
+	JWKS() token.JWKSet
 }
 
 type authService struct {
-	userRepo userDomain.UserRepository
-	logger   logger.Logger
+	userRepo    userDomain.UserRepository
+	userGuard   domain.UserGuard
+	refreshRepo domain.RefreshTokenRepository
+	revokedRepo domain.RevokedTokenRepository
+	resetRepo   domain.PasswordResetRepository
+	mfaRepo     domain.MFARepository
+	identities  domain.IdentityRepository
+	oidcStates  domain.OIDCStateRepository
+	attempts    domain.LoginAttemptStore
+	outboxRepo  outbox.Repository
+	tokens      *token.Manager
+	uow         database.UnitOfWork
+	logger      logger.Logger
+
+	// authenticators yapılandırılan kimlik sağlayıcılarıdır; defaultProvider istekte
+	// sağlayıcı belirtilmediğinde kullanılır.
+	authenticators  []domain.Authenticator
+	defaultProvider string
+
+	// now TOTP ve token süreleri için kullanılan saattir; testlerde sabitlenebilir.
+	now func() time.Time
 }
 
-func NewService(userRepository userDomain.UserRepository, logger logger.Logger) AuthService {
+func NewService(userRepository userDomain.UserRepository, userGuard domain.UserGuard, refreshRepo domain.RefreshTokenRepository, revokedRepo domain.RevokedTokenRepository, resetRepo domain.PasswordResetRepository, mfaRepo domain.MFARepository, identities domain.IdentityRepository, oidcStates domain.OIDCStateRepository, authenticators []domain.Authenticator, defaultProvider string, attempts domain.LoginAttemptStore, outboxRepo outbox.Repository, tokens *token.Manager, uow database.UnitOfWork, logger logger.Logger) AuthService {
 	return &authService{
-		userRepo: userRepository,
-		logger:   logger,
+		userRepo:    userRepository,
+		userGuard:   userGuard,
+		refreshRepo: refreshRepo,
+		revokedRepo: revokedRepo,
+		resetRepo:   resetRepo,
+		mfaRepo:     mfaRepo,
+		identities:  identities,
+		oidcStates:  oidcStates,
+		attempts:    attempts,
+		outboxRepo:  outboxRepo,
+		tokens:      tokens,
+		uow:         uow,
+		logger:      logger,
+
+		authenticators:  authenticators,
+		defaultProvider: defaultProvider,
+
+		now: time.Now,
 	}
 }
 
-func (s *authService) Login(req *domain.LoginRequest) (*domain.LoginResponse, error) {
+func (s *authService) Login(ctx context.Context, req *domain.LoginRequest) (*domain.LoginResponse, error) {
+	authenticator, err := s.passwordAuthenticator(req.Provider)
+	if err != nil {
+		return nil, err
+	}
 
-	if req.Username == "admin" && req.Password == "123" {
-		return s.generateTokenForTestUser("admin", "ADMIN", "Test", "Admin")
+	now := s.now()
+	if err := s.checkThrottle(ctx, req.Username, req.IP, now); err != nil {
+		return nil, err
 	}
-	if req.Username == "sekreter" && req.Password == "123" {
-		return s.generateTokenForTestUser("sekreter", "SEKRETER", "Test", "Sekreter")
+
+	identity, err := authenticator.Authenticate(ctx, domain.Credentials{
+		Username: req.Username,
+		Password: req.Password,
+	})
+	if err != nil {
+		switch err.(type) {
+		case domain.ErrInvalidCredentials:
+			s.logger.Error("Kimlik doğrulanamadı", err, map[string]interface{}{
+				"username": req.Username,
+				"provider": authenticator.Name(),
+				"ip":       req.IP,
+			})
+			// Event'lerin aggregate'i için kullanıcı yerel kayıttan bulunur; yoksa nil kalır.
+			user, _ := s.userRepo.GetByUsername(ctx, req.Username)
+			s.recordFailure(ctx, user, req.Username, req.IP, now)
+		case domain.ErrProviderUnavailable:
+			s.logger.Error("Identity provider unavailable", err, map[string]interface{}{
+				"provider": authenticator.Name(),
+				"ip":       req.IP,
+			})
+		default:
+			s.logger.Error("Failed to authenticate", err, map[string]interface{}{
+				"username": req.Username,
+				"provider": authenticator.Name(),
+			})
+		}
+		return nil, err
 	}
 
-	user, err := s.userRepo.GetByUsername(req.Username)
+	user, err := s.resolveUser(ctx, identity)
 	if err != nil {
-		s.logger.Error("Kullanıcı bulunamadı", err, map[string]interface{}{"username": req.Username})
-		return nil, domain.ErrInvalidCredentials{}
+		return nil, err
+	}
+	return s.finishLogin(ctx, user)
+}
+
+// finishLogin 2FA açıksa challenge döner, değilse oturumu açar.
+func (s *authService) finishLogin(ctx context.Context, user *userDomain.User) (*domain.LoginResponse, error) {
+	if err := checkUserStatus(user); err != nil {
+		s.logger.Error("Login rejected for inactive user", err, map[string]interface{}{
+			"username": user.Username,
+			"status":   user.Status,
+		})
+		return nil, err
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password))
+	mfa, err := s.mfaRepo.Get(ctx, user.Id)
 	if err != nil {
-		s.logger.Error("Şifre doğrulanamadı", err, map[string]interface{}{"username": req.Username})
-		return nil, domain.ErrInvalidCredentials{}
+		s.logger.Error("Failed to get mfa settings", err, map[string]interface{}{"username": user.Username})
+		return nil, err
+	}
+	if mfa.Enabled() {
+		challenge, err := s.issueMFAToken(user)
+		if err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrMFARequired{Challenge: *challenge}
 	}
 
-	expirationTime := time.Now().Add(24 * time.Hour)
-	claims := &domain.Claims{
-		UserID:   user.Id.String(),
-		Username: user.Username,
-		Role:     user.Role,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
-		},
+	return s.completeLogin(ctx, user)
+}
+
+// completeLogin hata sayacını sıfırlar ve access/refresh token çiftini üretir. 2FA açık
+// kullanıcılarda sayaç ancak ikinci adımdan sonra sıfırlanır; aksi halde şifreyi bilen biri
+// her girişte kod denemelerini sıfırlayabilirdi.
+func (s *authService) completeLogin(ctx context.Context, user *userDomain.User) (*domain.LoginResponse, error) {
+	if err := s.attempts.Reset(ctx, userAttemptKey(user.Username)); err != nil {
+		s.logger.Error("Failed to reset login attempts", err, map[string]interface{}{"username": user.Username})
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString(getJWTKey())
+	var refreshToken string
+	err := s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		refreshToken, err = s.issueRefreshToken(ctx, tx, user.Id, uuid.New())
+		return err
+	})
 	if err != nil {
-		s.logger.Error("Token oluşturulamadı", err, nil)
+		s.logger.Error("Refresh token oluşturulamadı", err, map[string]interface{}{"username": user.Username})
 		return nil, domain.ErrTokenGeneration{}
 	}
 
+	resp, err := s.issueAccessToken(user)
+	if err != nil {
+		return nil, err
+	}
+	resp.RefreshToken = refreshToken
+
 	s.logger.Info("Kullanıcı giriş yaptı", map[string]interface{}{
 		"user": user.Username,
 		"role": user.Role,
 	})
 
-	return &domain.LoginResponse{
-		Token:    tokenString,
-		Username: user.Username,
-		Role:     user.Role,
-		Ad:       user.Ad,
-		Soyad:    user.Soyad,
-	}, nil
+	return resp, nil
 }
 
-func (s *authService) generateTokenForTestUser(username, role, ad, soyad string) (*domain.LoginResponse, error) {
-	expirationTime := time.Now().Add(24 * time.Hour)
-	claims := &domain.Claims{
-		UserID:   "00000000-0000-0000-0000-000000000000", // Test user UUID
-		Username: username,
-		Role:     role,
+// Refresh refresh token'ı tek kullanımlık olarak döndürür. Daha önce kullanılmış veya
+// iptal edilmiş bir token gelirse aynı ailedeki tüm token'lar iptal edilir.
+func (s *authService) Refresh(ctx context.Context, req *domain.RefreshRequest) (*domain.LoginResponse, error) {
+	var (
+		current      *domain.RefreshToken
+		refreshToken string
+		reused       bool
+	)
+
+	err := s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		current, err = s.refreshRepo.GetByHashForUpdate(ctx, tx, hashToken(req.RefreshToken))
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return domain.ErrInvalidRefreshToken{}
+		}
+
+		if current.UsedAt != nil || current.RevokedAt != nil {
+			reused = current.UsedAt != nil
+			if reused {
+				return s.refreshRepo.RevokeFamily(ctx, tx, current.FamilyID)
+			}
+			return domain.ErrInvalidRefreshToken{}
+		}
+		if s.now().After(current.ExpiresAt) {
+			return domain.ErrInvalidRefreshToken{}
+		}
+
+		if err := s.refreshRepo.MarkUsed(ctx, tx, current.ID); err != nil {
+			return err
+		}
+		refreshToken, err = s.issueRefreshToken(ctx, tx, current.UserID, current.FamilyID)
+		return err
+	})
+	if err != nil {
+		if _, ok := err.(domain.ErrInvalidRefreshToken); !ok {
+			s.logger.Error("Failed to rotate refresh token", err, nil)
+		}
+		return nil, err
+	}
+
+	if reused {
+		s.logger.Info("Refresh token reuse detected, family revoked", map[string]interface{}{
+			"action":    "REFRESH_TOKEN_REUSE",
+			"user_id":   current.UserID.String(),
+			"family_id": current.FamilyID.String(),
+		})
+		return nil, domain.ErrRefreshTokenReused{}
+	}
+
+	user, err := s.userRepo.GetByUserID(ctx, current.UserID)
+	if err != nil {
+		s.logger.Error("Failed to get user for refresh", err, map[string]interface{}{
+			"user_id": current.UserID.String(),
+		})
+		return nil, domain.ErrInvalidRefreshToken{}
+	}
+	if !user.IsActive() {
+		if err := s.refreshRepo.RevokeFamily(ctx, nil, current.FamilyID); err != nil {
+			s.logger.Error("Failed to revoke refresh token family", err, map[string]interface{}{
+				"user_id": current.UserID.String(),
+			})
+		}
+		return nil, domain.ErrInvalidRefreshToken{}
+	}
+
+	resp, err := s.issueAccessToken(user)
+	if err != nil {
+		return nil, err
+	}
+	resp.RefreshToken = refreshToken
+
+	return resp, nil
+}
+
+func (s *authService) Logout(ctx context.Context, jti string, expiresAt time.Time, req *domain.LogoutRequest) error {
+	if err := s.revokedRepo.Revoke(ctx, jti, expiresAt); err != nil {
+		s.logger.Error("Failed to revoke access token", err, map[string]interface{}{
+			"jti": jti,
+		})
+		return err
+	}
+
+	if req.RefreshToken != "" {
+		err := s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+			token, err := s.refreshRepo.GetByHashForUpdate(ctx, tx, hashToken(req.RefreshToken))
+			if err != nil || token == nil {
+				return err
+			}
+			return s.refreshRepo.RevokeFamily(ctx, tx, token.FamilyID)
+		})
+		if err != nil {
+			s.logger.Error("Failed to revoke refresh token", err, nil)
+			return err
+		}
+	}
+
+	s.logger.Info("Kullanıcı çıkış yaptı", map[string]interface{}{
+		"action": "LOGOUT",
+		"jti":    jti,
+	})
+
+	return nil
+}
+
+func (s *authService) RevokeUserSessions(ctx context.Context, userID uuid.UUID) error {
+	if err := s.userGuard.CheckManageable(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := s.refreshRepo.RevokeByUser(ctx, userID); err != nil {
+		s.logger.Error("Failed to revoke user sessions", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return err
+	}
+
+	s.logger.Info("User sessions revoked", map[string]interface{}{
+		"action":  "SESSIONS_REVOKE",
+		"user_id": userID.String(),
+	})
+
+	return nil
+}
+
+// UnlockUser admin tarafından hesap kilidini ve kullanıcı adına ait hata sayacını kaldırır.
+func (s *authService) UnlockUser(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.userRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return userDomain.ErrUserNotFound{}
+		}
+		s.logger.Error("Failed to get user", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return err
+	}
+
+	if err := s.attempts.Reset(ctx, userAttemptKey(user.Username)); err != nil {
+		s.logger.Error("Failed to unlock user", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return err
+	}
+
+	s.logger.Info("User unlocked", map[string]interface{}{
+		"action":   "ACCOUNT_UNLOCK",
+		"user_id":  userID.String(),
+		"username": user.Username,
+	})
+
+	return nil
+}
+
+func (s *authService) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	return s.revokedRepo.IsRevoked(ctx, jti)
+}
+
+// IsUserActive askıya alınmış veya silinmiş kullanıcıların süresi dolmamış token'larını
+// geçersiz kılmak için her istekte çağrılır.
+func (s *authService) IsUserActive(ctx context.Context, userID string) (bool, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return false, nil
+	}
+
+	status, err := s.userRepo.GetStatus(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		s.logger.Error("Failed to get user status", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		return false, err
+	}
+	return status == userDomain.StatusActive, nil
+}
+
+// checkUserStatus silinmiş hesapları hiç yokmuş gibi ErrInvalidCredentials ile reddeder.
+func checkUserStatus(user *userDomain.User) error {
+	switch user.Status {
+	case userDomain.StatusActive:
+		return nil
+	case userDomain.StatusDeleted:
+		return domain.ErrInvalidCredentials{}
+	default:
+		return domain.ErrAccountDisabled{}
+	}
+}
+
+func (s *authService) JWKS() token.JWKSet {
+	return s.tokens.JWKS()
+}
+
+func (s *authService) issueAccessToken(user *userDomain.User) (*domain.LoginResponse, error) {
+	now := s.now()
+	expirationTime := now.Add(accessTokenTTL)
+	claims := &token.Claims{
+		UserID:   user.Id.String(),
+		Username: user.Username,
+		Role:     user.Role,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			IssuedAt:  jwt.NewNumericDate(now),
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString(getJWTKey())
+	tokenString, err := s.tokens.Sign(claims)
 	if err != nil {
+		s.logger.Error("Token oluşturulamadı", err, nil)
 		return nil, domain.ErrTokenGeneration{}
 	}
 
-	s.logger.Info("Test kullanıcı giriş yaptı", map[string]interface{}{
-		"user": username,
-		"role": role,
-	})
-
 	return &domain.LoginResponse{
-		Token:    tokenString,
-		Username: username,
-		Role:     role,
-		Ad:       ad,
-		Soyad:    soyad,
+		Token:          tokenString,
+		TokenExpiresAt: expirationTime,
+		Username:       user.Username,
+		Role:           user.Role,
+		Ad:             user.Ad,
+		Soyad:          user.Soyad,
 	}, nil
 }
+
+func (s *authService) issueRefreshToken(ctx context.Context, tx *sqlx.Tx, userID, familyID uuid.UUID) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	raw := base64.RawURLEncoding.EncodeToString(buf)
+
+	now := s.now()
+	token := &domain.RefreshToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: hashToken(raw),
+		ExpiresAt: now.Add(refreshTokenTTL),
+		CreatedAt: now,
+	}
+	if err := s.refreshRepo.Create(ctx, tx, token); err != nil {
+		return "", err
+	}
+	return raw, nil
+}
+
+func hashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
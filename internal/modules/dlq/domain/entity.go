@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"encoding/json"
+	"regexp"
+	"time"
+)
+
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 200
+)
+
+// streamIDPattern Redis stream ID'leridir: <ms>-<seq> veya sadece <ms>.
+var streamIDPattern = regexp.MustCompile(`^\d+(-\d+)?$`)
+
+func IsValidMessageID(id string) bool {
+	return streamIDPattern.MatchString(id)
+}
+
+// Topic DLQ'sunda mesaj bekleyen kaynak stream'dir.
+type Topic struct {
+	Topic string `json:"topic"`
+	Depth int64  `json:"depth"`
+}
+
+// Message DLQ'daki bir mesajdır. Hata bilgileri, yeniden deneme desteğinden önce DLQ'ya
+// taşınmış mesajlarda boştur.
+type Message struct {
+	ID         string          `json:"id"`
+	OriginalID string          `json:"original_id,omitempty"`
+	Attempts   int             `json:"attempts,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	FailedAt   *time.Time      `json:"failed_at,omitempty"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+type MessagePage struct {
+	Items      []Message `json:"items"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+}
+
+type MessageFilter struct {
+	Cursor string `validate:"omitempty,max=40"`
+	Limit  int    `validate:"omitempty,min=1,max=200"`
+}
+
+// ReplayRequest ya ids ile tek tek ya da from/to ile (ikisi dahil) bir aralıktaki mesajları
+// kaynak stream'e geri yazar. from ve to için "-" ve "+" DLQ'nun başını ve sonunu ifade eder.
+type ReplayRequest struct {
+	IDs  []string `json:"ids" validate:"omitempty,max=100,dive,required"`
+	From string   `json:"from" validate:"omitempty,max=40"`
+	To   string   `json:"to" validate:"omitempty,max=40"`
+}
+
+type ReplayResult struct {
+	Replayed int      `json:"replayed"`
+	NotFound []string `json:"not_found,omitempty"`
+	// HasMore aralıkta MaxReplayRange sınırı nedeniyle replay edilmeyen mesaj kaldığını belirtir.
+	HasMore bool `json:"has_more"`
+}
+
+type PurgeResult struct {
+	Deleted int64 `json:"deleted"`
+}
+
+type ErrTopicNotFound struct{}
+
+func (e ErrTopicNotFound) Error() string {
+	return "dead-letter queue not found for topic"
+}
+
+type ErrMessageNotFound struct{}
+
+func (e ErrMessageNotFound) Error() string {
+	return "dead-letter message not found"
+}
+
+type ErrInvalidMessageID struct {
+	ID string
+}
+
+func (e ErrInvalidMessageID) Error() string {
+	return "invalid stream message id: " + e.ID
+}
+
+type ErrInvalidReplay struct{}
+
+func (e ErrInvalidReplay) Error() string {
+	return "either ids or from/to must be given"
+}
@@ -0,0 +1,129 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/dlq/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/dlq/service"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+type DLQHandler struct {
+	service  service.DLQService
+	validate *validator.Validate
+}
+
+func NewHandler(svc service.DLQService) *DLQHandler {
+	return &DLQHandler{
+		service:  svc,
+		validate: validation.Get(),
+	}
+}
+
+func (h *DLQHandler) ListTopics(w http.ResponseWriter, r *http.Request) {
+	topics, err := h.service.ListTopics(r.Context())
+	if err != nil {
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "DLQ topic'leri getirilemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, topics, http.StatusOK, "DLQ topic'leri başarıyla getirildi")
+}
+
+func (h *DLQHandler) ListMessages(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	filter := &domain.MessageFilter{Cursor: q.Get("cursor")}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz sorgu parametresi", "limit sayı olmalıdır")
+			utils.Return(w, http.StatusBadRequest, resp)
+			return
+		}
+		filter.Limit = limit
+	}
+
+	if err := h.validate.Struct(filter); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz sorgu parametresi", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	page, err := h.service.ListMessages(r.Context(), mux.Vars(r)["topic"], filter)
+	if err != nil {
+		h.writeError(w, err, "DLQ mesajları getirilemedi")
+		return
+	}
+
+	utils.WriteJson(w, page, http.StatusOK, "DLQ mesajları başarıyla getirildi")
+}
+
+func (h *DLQHandler) Replay(w http.ResponseWriter, r *http.Request) {
+	var req domain.ReplayRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	result, err := h.service.Replay(r.Context(), mux.Vars(r)["topic"], &req)
+	if err != nil {
+		h.writeError(w, err, "DLQ mesajları yeniden oynatılamadı")
+		return
+	}
+
+	utils.WriteJson(w, result, http.StatusOK, "DLQ mesajları kaynak stream'e geri yazıldı")
+}
+
+func (h *DLQHandler) DeleteMessage(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	if err := h.service.DeleteMessage(r.Context(), vars["topic"], vars["id"]); err != nil {
+		h.writeError(w, err, "DLQ mesajı silinemedi")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "DLQ mesajı başarıyla silindi")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *DLQHandler) Purge(w http.ResponseWriter, r *http.Request) {
+	result, err := h.service.Purge(r.Context(), mux.Vars(r)["topic"])
+	if err != nil {
+		h.writeError(w, err, "DLQ temizlenemedi")
+		return
+	}
+
+	utils.WriteJson(w, result, http.StatusOK, "DLQ başarıyla temizlendi")
+}
+
+func (h *DLQHandler) writeError(w http.ResponseWriter, err error, message string) {
+	switch err.(type) {
+	case domain.ErrTopicNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Bu topic için DLQ bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrMessageNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "DLQ mesajı bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrInvalidMessageID:
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz mesaj ID'si", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	case domain.ErrInvalidReplay:
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "ids veya from/to alanlarından sadece biri verilmelidir", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	default:
+		resp := utils.ErrorResponse("INTERNAL_ERROR", message, err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+	}
+}
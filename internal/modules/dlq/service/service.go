@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/eventbus"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/dlq/domain"
+)
+
+type DLQService interface {
+	ListTopics(ctx context.Context) ([]domain.Topic, error)
+	ListMessages(ctx context.Context, topic string, filter *domain.MessageFilter) (*domain.MessagePage, error)
+	Replay(ctx context.Context, topic string, req *domain.ReplayRequest) (*domain.ReplayResult, error)
+	DeleteMessage(ctx context.Context, topic, id string) error
+	Purge(ctx context.Context, topic string) (*domain.PurgeResult, error)
+}
+
+type dlqService struct {
+	dlq    eventbus.DeadLetterQueue
+	logger logger.Logger
+}
+
+func NewDLQService(dlq eventbus.DeadLetterQueue, logger logger.Logger) DLQService {
+	return &dlqService{
+		dlq:    dlq,
+		logger: logger,
+	}
+}
+
+func (s *dlqService) ListTopics(ctx context.Context) ([]domain.Topic, error) {
+	dlqTopics, err := s.dlq.Topics(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list DLQ topics", err, nil)
+		return nil, err
+	}
+
+	topics := make([]domain.Topic, 0, len(dlqTopics))
+	for _, t := range dlqTopics {
+		topics = append(topics, domain.Topic{Topic: t.Topic, Depth: t.Depth})
+	}
+	return topics, nil
+}
+
+// ListMessages DLQ'yu eskiden yeniye, stream ID'sine göre sayfalar. İmleç son görülen
+// mesajın ID'sidir.
+func (s *dlqService) ListMessages(ctx context.Context, topic string, filter *domain.MessageFilter) (*domain.MessagePage, error) {
+	if filter.Cursor != "" && !domain.IsValidMessageID(filter.Cursor) {
+		return nil, domain.ErrInvalidMessageID{ID: filter.Cursor}
+	}
+	limit := filter.Limit
+	if limit == 0 {
+		limit = domain.DefaultListLimit
+	}
+
+	letters, err := s.dlq.List(ctx, topic, filter.Cursor, int64(limit))
+	if err != nil {
+		return nil, s.mapError(err, "Failed to list DLQ messages", topic)
+	}
+
+	page := &domain.MessagePage{Items: make([]domain.Message, 0, len(letters))}
+	for _, l := range letters {
+		page.Items = append(page.Items, domain.Message{
+			ID:         l.ID,
+			OriginalID: l.OriginalID,
+			Attempts:   l.Attempts,
+			Error:      l.Error,
+			FailedAt:   l.FailedAt,
+			Payload:    l.Payload,
+		})
+	}
+	if len(letters) == limit {
+		page.NextCursor = letters[len(letters)-1].ID
+	}
+
+	return page, nil
+}
+
+// Replay mesajları kaynak stream'e yeni mesaj olarak yazar; consumer'lar mesajı ilk kez
+// geliyormuş gibi işler ve yeniden deneme hakları sıfırlanır.
+func (s *dlqService) Replay(ctx context.Context, topic string, req *domain.ReplayRequest) (*domain.ReplayResult, error) {
+	byID := len(req.IDs) > 0
+	byRange := req.From != "" || req.To != ""
+	if byID == byRange {
+		return nil, domain.ErrInvalidReplay{}
+	}
+
+	result := &domain.ReplayResult{}
+	if byID {
+		for _, id := range req.IDs {
+			if !domain.IsValidMessageID(id) {
+				return nil, domain.ErrInvalidMessageID{ID: id}
+			}
+		}
+
+		replayed, err := s.dlq.Replay(ctx, topic, req.IDs)
+		if err != nil {
+			return nil, s.mapError(err, "Failed to replay DLQ messages", topic)
+		}
+
+		done := make(map[string]bool, len(replayed))
+		for _, id := range replayed {
+			done[id] = true
+		}
+		for _, id := range req.IDs {
+			if !done[id] {
+				result.NotFound = append(result.NotFound, id)
+			}
+		}
+		result.Replayed = len(replayed)
+	} else {
+		from, to := req.From, req.To
+		if from == "" {
+			from = "-"
+		}
+		if to == "" {
+			to = "+"
+		}
+		for _, id := range []string{from, to} {
+			if id != "-" && id != "+" && !domain.IsValidMessageID(id) {
+				return nil, domain.ErrInvalidMessageID{ID: id}
+			}
+		}
+
+		replayed, hasMore, err := s.dlq.ReplayRange(ctx, topic, from, to, eventbus.MaxReplayRange)
+		if err != nil {
+			return nil, s.mapError(err, "Failed to replay DLQ messages", topic)
+		}
+		result.Replayed, result.HasMore = replayed, hasMore
+	}
+
+	s.logger.Info("DLQ messages replayed", map[string]interface{}{
+		"action":   "DLQ_REPLAY",
+		"actor":    utils.GetUsernameFromContext(ctx),
+		"topic":    topic,
+		"replayed": result.Replayed,
+		"from":     req.From,
+		"to":       req.To,
+	})
+
+	return result, nil
+}
+
+func (s *dlqService) DeleteMessage(ctx context.Context, topic, id string) error {
+	if !domain.IsValidMessageID(id) {
+		return domain.ErrInvalidMessageID{ID: id}
+	}
+
+	deleted, err := s.dlq.Delete(ctx, topic, []string{id})
+	if err != nil {
+		return s.mapError(err, "Failed to delete DLQ message", topic)
+	}
+	if deleted == 0 {
+		return domain.ErrMessageNotFound{}
+	}
+
+	s.logger.Info("DLQ message deleted", map[string]interface{}{
+		"action":     "DLQ_DELETE",
+		"actor":      utils.GetUsernameFromContext(ctx),
+		"topic":      topic,
+		"message_id": id,
+	})
+
+	return nil
+}
+
+func (s *dlqService) Purge(ctx context.Context, topic string) (*domain.PurgeResult, error) {
+	deleted, err := s.dlq.Purge(ctx, topic)
+	if err != nil {
+		return nil, s.mapError(err, "Failed to purge DLQ", topic)
+	}
+
+	s.logger.Info("DLQ purged", map[string]interface{}{
+		"action":  "DLQ_PURGE",
+		"actor":   utils.GetUsernameFromContext(ctx),
+		"topic":   topic,
+		"deleted": deleted,
+	})
+
+	return &domain.PurgeResult{Deleted: deleted}, nil
+}
+
+// mapError eventbus hatalarını domain hatalarına çevirir, beklenmeyen hataları loglar.
+func (s *dlqService) mapError(err error, message, topic string) error {
+	if err == eventbus.ErrDLQNotFound {
+		return domain.ErrTopicNotFound{}
+	}
+	s.logger.Error(message, err, map[string]interface{}{
+		"topic": topic,
+	})
+	return err
+}
@@ -37,7 +37,116 @@ func (l *TaskEventListener) HandleTaskAssigned(payload []byte) error {
 func (l *TaskEventListener) sendEmail(event events.TaskAssignedEvent) error {
 	log.Printf("   📨 TO: %s", event.UserEmail)
 	log.Printf("   📨 SUBJECT: Yeni Görev Atandı: %s", event.TaskTitle)
-	log.Printf("   📨 BODY: Merhaba %s, size yeni bir görev atandı!", event.UserName)
+	if event.TeamName != "" {
+		log.Printf("   📨 BODY: Merhaba %s, %s takımının görevi size atandı!", event.UserName, event.TeamName)
+	} else {
+		log.Printf("   📨 BODY: Merhaba %s, size yeni bir görev atandı!", event.UserName)
+	}
+
+	return nil
+}
+
+func (l *TaskEventListener) HandleTaskTeamAssigned(payload []byte) error {
+	var event events.TaskTeamAssignedEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return fmt.Errorf("failed to unmarshal TaskTeamAssignedEvent: %w", err)
+	}
+
+	log.Printf("👥 TAKIMA TASK ATAMASI!")
+	log.Printf("   👥 Takım: %s (ID: %s)", event.TeamName, event.TeamID)
+	log.Printf("   📋 Task: %s (ID: %s)", event.TaskTitle, event.TaskID)
+	log.Printf("   👤 Atayan: %s", event.AssignedByName)
+	log.Printf("   📧 %d kişiye email gönderiliyor...", len(event.Recipients))
+
+	for _, recipient := range event.Recipients {
+		if err := l.sendTeamAssignedEmail(event, recipient); err != nil {
+			log.Printf("   ❌ Email gönderilemedi (%s): %v", recipient.UserEmail, err)
+			return err
+		}
+	}
+
+	log.Printf("   ✅ Takım bildirimleri gönderildi!")
+	return nil
+}
+
+func (l *TaskEventListener) sendTeamAssignedEmail(event events.TaskTeamAssignedEvent, recipient events.Recipient) error {
+	log.Printf("   📨 TO: %s", recipient.UserEmail)
+	log.Printf("   📨 SUBJECT: Takımınıza Yeni Görev Atandı: %s", event.TaskTitle)
+	log.Printf("   📨 BODY: Merhaba %s, \"%s\" görevi %s takımına atandı.", recipient.UserName, event.TaskTitle, event.TeamName)
+
+	return nil
+}
+
+func (l *TaskEventListener) HandleTaskStatusChanged(payload []byte) error {
+	var event events.TaskStatusChangedEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return fmt.Errorf("failed to unmarshal TaskStatusChangedEvent: %w", err)
+	}
+
+	log.Printf("🔄 TASK DURUMU DEĞİŞTİ!")
+	log.Printf("   📋 Task: %s (ID: %s)", event.TaskTitle, event.TaskID)
+	log.Printf("   ➡️  %s → %s", event.OldStatus, event.NewStatus)
+	log.Printf("   👤 Değiştiren: %s", event.ActorName)
+
+	return nil
+}
+
+func (l *TaskEventListener) HandleTaskDone(payload []byte) error {
+	var event events.TaskDoneEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return fmt.Errorf("failed to unmarshal TaskDoneEvent: %w", err)
+	}
+
+	log.Printf("✅ TASK TAMAMLANDI!")
+	log.Printf("   📋 Task: %s (ID: %s)", event.TaskTitle, event.TaskID)
+	log.Printf("   👤 Tamamlayan: %s", event.CompletedByName)
+	log.Printf("   📧 %d kişiye email gönderiliyor...", len(event.Recipients))
+
+	for _, recipient := range event.Recipients {
+		if err := l.sendCompletionEmail(event, recipient); err != nil {
+			log.Printf("   ❌ Email gönderilemedi (%s): %v", recipient.UserEmail, err)
+			return err
+		}
+	}
+
+	log.Printf("   ✅ Tamamlanma bildirimleri gönderildi!")
+	return nil
+}
+
+func (l *TaskEventListener) sendCompletionEmail(event events.TaskDoneEvent, recipient events.Recipient) error {
+	log.Printf("   📨 TO: %s", recipient.UserEmail)
+	log.Printf("   📨 SUBJECT: Görev Tamamlandı: %s", event.TaskTitle)
+	log.Printf("   📨 BODY: Merhaba %s, \"%s\" görevi %s tarafından tamamlandı.", recipient.UserName, event.TaskTitle, event.CompletedByName)
+
+	return nil
+}
+
+func (l *TaskEventListener) HandleCommentMention(payload []byte) error {
+	var event events.CommentMentionEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return fmt.Errorf("failed to unmarshal CommentMentionEvent: %w", err)
+	}
+
+	log.Printf("💬 YORUMDA ANILDINIZ!")
+	log.Printf("   📋 Task: %s (ID: %s)", event.TaskTitle, event.TaskID)
+	log.Printf("   👤 Yazan: %s", event.AuthorName)
+	log.Printf("   📧 %d kişiye email gönderiliyor...", len(event.Recipients))
+
+	for _, recipient := range event.Recipients {
+		if err := l.sendMentionEmail(event, recipient); err != nil {
+			log.Printf("   ❌ Email gönderilemedi (%s): %v", recipient.UserEmail, err)
+			return err
+		}
+	}
+
+	log.Printf("   ✅ Mention bildirimleri gönderildi!")
+	return nil
+}
+
+func (l *TaskEventListener) sendMentionEmail(event events.CommentMentionEvent, recipient events.Recipient) error {
+	log.Printf("   📨 TO: %s", recipient.UserEmail)
+	log.Printf("   📨 SUBJECT: %s sizi bir yorumda andı: %s", event.AuthorName, event.TaskTitle)
+	log.Printf("   📨 BODY: Merhaba %s, \"%s\" görevindeki yorum: %s", recipient.UserName, event.TaskTitle, event.Excerpt)
 
 	return nil
 }
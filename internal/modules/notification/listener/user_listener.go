@@ -0,0 +1,56 @@
+package listener
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/events"
+)
+
+const defaultPasswordResetURL = "http://localhost:3000/reset-password"
+
+type UserEventListener struct {
+	resetURL string
+}
+
+// NewUserEventListener sıfırlama bağlantısının tabanını PASSWORD_RESET_URL'den alır.
+func NewUserEventListener() *UserEventListener {
+	resetURL := os.Getenv("PASSWORD_RESET_URL")
+	if resetURL == "" {
+		resetURL = defaultPasswordResetURL
+	}
+	return &UserEventListener{resetURL: resetURL}
+}
+
+func (l *UserEventListener) HandlePasswordResetRequested(payload []byte) error {
+	var event events.PasswordResetRequestedEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return fmt.Errorf("failed to unmarshal PasswordResetRequestedEvent: %w", err)
+	}
+
+	log.Printf("🔑 ŞİFRE SIFIRLAMA TALEBİ!")
+	log.Printf("   👤 Kullanıcı: %s (%s)", event.Username, event.UserEmail)
+	log.Printf("   📧 Email gönderiliyor...")
+
+	if err := l.sendPasswordResetEmail(event); err != nil {
+		log.Printf("   ❌ Email gönderilemedi: %v", err)
+		return err
+	}
+
+	log.Printf("   ✅ Şifre sıfırlama emaili gönderildi!")
+	return nil
+}
+
+func (l *UserEventListener) sendPasswordResetEmail(event events.PasswordResetRequestedEvent) error {
+	link := l.resetURL + "?token=" + url.QueryEscape(event.Token)
+
+	log.Printf("   📨 TO: %s", event.UserEmail)
+	log.Printf("   📨 SUBJECT: Şifre Sıfırlama")
+	log.Printf("   📨 BODY: Merhaba %s, şifrenizi sıfırlamak için bağlantı (%s tarihine kadar geçerli): %s",
+		event.Username, event.ExpiresAt.Format("02.01.2006 15:04"), link)
+
+	return nil
+}
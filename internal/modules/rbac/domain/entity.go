@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	PermUserRead       = "user:read"
+	PermUserManage     = "user:manage"
+	PermTaskRead       = "task:read"
+	PermTaskCreate     = "task:create"
+	PermTaskEdit       = "task:edit"
+	PermTaskDelete     = "task:delete"
+	PermTaskStatus     = "task:status"
+	PermTaskAssign     = "task:assign"
+	PermTaskComment    = "task:comment"
+	PermTaskDistribute = "task:distribute"
+	PermTagManage      = "tag:manage"
+	PermScopeManage    = "scope:manage"
+	PermWorkflowManage = "workflow:manage"
+	PermRoleManage     = "role:manage"
+	PermTeamManage     = "team:manage"
+	PermEventManage    = "event:manage"
+)
+
+// AdminRole her zaman tüm izinlere sahip olan sistem rolüdür.
+const AdminRole = "ADMIN"
+
+type Role struct {
+	Name        string         `json:"name" db:"name"`
+	Description string         `json:"description" db:"description"`
+	IsSystem    bool           `json:"is_system" db:"is_system"`
+	Permissions pq.StringArray `json:"permissions" db:"permissions"`
+	CreatedAt   time.Time      `json:"created_at" db:"created_at"`
+}
+
+type Permission struct {
+	Key         string `json:"key" db:"key"`
+	Description string `json:"description" db:"description"`
+}
+
+type CreateRoleRequest struct {
+	Name        string   `json:"name" validate:"required,min=2,max=20,alphanum"`
+	Description string   `json:"description" validate:"max=255"`
+	Permissions []string `json:"permissions" validate:"dive,required,max=50"`
+}
+
+type UpdateRoleRequest struct {
+	Description *string  `json:"description" validate:"omitnil,max=255"`
+	Permissions []string `json:"permissions" validate:"omitnil,dive,required,max=50"`
+}
+
+type ErrRoleNotFound struct{}
+
+func (e ErrRoleNotFound) Error() string {
+	return "role not found"
+}
+
+type ErrRoleExists struct{}
+
+func (e ErrRoleExists) Error() string {
+	return "role already exists"
+}
+
+type ErrRoleInUse struct{}
+
+func (e ErrRoleInUse) Error() string {
+	return "role is assigned to users"
+}
+
+type ErrSystemRole struct{}
+
+func (e ErrSystemRole) Error() string {
+	return "system role cannot be modified or deleted"
+}
+
+type ErrUnknownPermission struct {
+	Key string
+}
+
+func (e ErrUnknownPermission) Error() string {
+	return "unknown permission: " + e.Key
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "context"
+
+type RoleRepository interface {
+	GetAll(ctx context.Context) ([]Role, error)
+	GetByName(ctx context.Context, name string) (*Role, error)
+	Create(ctx context.Context, role *Role) error
+	Update(ctx context.Context, role *Role) error
+	Delete(ctx context.Context, name string) error
+
+	GetPermissions(ctx context.Context) ([]Permission, error)
+	GetRolePermissions(ctx context.Context, name string) ([]string, error)
+}
@@ -0,0 +1,136 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/rbac/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/rbac/service"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+type RoleHandler struct {
+	service  service.RoleService
+	validate *validator.Validate
+}
+
+func NewHandler(svc service.RoleService) *RoleHandler {
+	return &RoleHandler{
+		service:  svc,
+		validate: validation.Get(),
+	}
+}
+
+func (h *RoleHandler) ListRoles(w http.ResponseWriter, r *http.Request) {
+	roles, err := h.service.ListRoles(r.Context())
+	if err != nil {
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Roller getirilemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, roles, http.StatusOK, "Roller başarıyla getirildi")
+}
+
+func (h *RoleHandler) GetRole(w http.ResponseWriter, r *http.Request) {
+	role, err := h.service.GetRole(r.Context(), mux.Vars(r)["name"])
+	if err != nil {
+		h.writeError(w, err, "Rol getirilemedi")
+		return
+	}
+
+	utils.WriteJson(w, role, http.StatusOK, "Rol başarıyla getirildi")
+}
+
+func (h *RoleHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	var req domain.CreateRoleRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	role, err := h.service.CreateRole(r.Context(), &req)
+	if err != nil {
+		h.writeError(w, err, "Rol oluşturulamadı")
+		return
+	}
+
+	utils.WriteJson(w, role, http.StatusCreated, "Rol başarıyla oluşturuldu")
+}
+
+func (h *RoleHandler) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	var req domain.UpdateRoleRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	role, err := h.service.UpdateRole(r.Context(), mux.Vars(r)["name"], &req)
+	if err != nil {
+		h.writeError(w, err, "Rol güncellenemedi")
+		return
+	}
+
+	utils.WriteJson(w, role, http.StatusOK, "Rol başarıyla güncellendi")
+}
+
+func (h *RoleHandler) DeleteRole(w http.ResponseWriter, r *http.Request) {
+	if err := h.service.DeleteRole(r.Context(), mux.Vars(r)["name"]); err != nil {
+		h.writeError(w, err, "Rol silinemedi")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Rol başarıyla silindi")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *RoleHandler) ListPermissions(w http.ResponseWriter, r *http.Request) {
+	permissions, err := h.service.ListPermissions(r.Context())
+	if err != nil {
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Yetkiler getirilemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, permissions, http.StatusOK, "Yetkiler başarıyla getirildi")
+}
+
+func (h *RoleHandler) decode(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	return true
+}
+
+func (h *RoleHandler) writeError(w http.ResponseWriter, err error, message string) {
+	switch err.(type) {
+	case domain.ErrRoleNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Rol bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrRoleExists:
+		resp := utils.ErrorResponse("ROLE_EXISTS", "Bu isimde bir rol zaten var", err.Error())
+		utils.Return(w, http.StatusConflict, resp)
+	case domain.ErrRoleInUse:
+		resp := utils.ErrorResponse("ROLE_IN_USE", "Rol kullanıcılara atanmış olduğu için silinemez", err.Error())
+		utils.Return(w, http.StatusConflict, resp)
+	case domain.ErrSystemRole:
+		resp := utils.ErrorResponse("SYSTEM_ROLE", "Sistem rolü değiştirilemez veya silinemez", err.Error())
+		utils.Return(w, http.StatusConflict, resp)
+	case domain.ErrUnknownPermission:
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Bilinmeyen yetki", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	default:
+		resp := utils.ErrorResponse("INTERNAL_ERROR", message, err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+	}
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/rbac/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const roleColumns = `
+	r.name, r.description, r.is_system, r.created_at,
+	COALESCE(ARRAY(SELECT rp.permission FROM role_permissions rp WHERE rp.role = r.name ORDER BY rp.permission), '{}') AS permissions
+`
+
+type PostgresRoleRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRoleRepository(db *sqlx.DB) domain.RoleRepository {
+	return &PostgresRoleRepository{db: db}
+}
+
+func (r *PostgresRoleRepository) GetAll(ctx context.Context) ([]domain.Role, error) {
+	roles := []domain.Role{}
+	query := `SELECT ` + roleColumns + ` FROM roles r ORDER BY r.is_system DESC, r.name ASC`
+	if err := r.db.SelectContext(ctx, &roles, query); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+func (r *PostgresRoleRepository) GetByName(ctx context.Context, name string) (*domain.Role, error) {
+	role := &domain.Role{}
+	query := `SELECT ` + roleColumns + ` FROM roles r WHERE r.name = $1`
+	err := r.db.GetContext(ctx, role, query, name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return role, nil
+}
+
+func (r *PostgresRoleRepository) Create(ctx context.Context, role *domain.Role) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO roles (name, description)
+		VALUES ($1, $2)
+		RETURNING is_system, created_at
+	`
+	if err := tx.QueryRowxContext(ctx, query, role.Name, role.Description).Scan(&role.IsSystem, &role.CreatedAt); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return domain.ErrRoleExists{}
+		}
+		return err
+	}
+
+	if err := replacePermissions(ctx, tx, role.Name, role.Permissions); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRoleRepository) Update(ctx context.Context, role *domain.Role) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `UPDATE roles SET description = $2 WHERE name = $1`, role.Name, role.Description)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrRoleNotFound{}
+	}
+
+	if err := replacePermissions(ctx, tx, role.Name, role.Permissions); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRoleRepository) Delete(ctx context.Context, name string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM roles WHERE name = $1`, name)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return domain.ErrRoleInUse{}
+		}
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrRoleNotFound{}
+	}
+	return nil
+}
+
+func (r *PostgresRoleRepository) GetPermissions(ctx context.Context) ([]domain.Permission, error) {
+	permissions := []domain.Permission{}
+	query := `SELECT key, description FROM permissions ORDER BY key ASC`
+	if err := r.db.SelectContext(ctx, &permissions, query); err != nil {
+		return nil, err
+	}
+	return permissions, nil
+}
+
+func (r *PostgresRoleRepository) GetRolePermissions(ctx context.Context, name string) ([]string, error) {
+	permissions := []string{}
+	query := `SELECT permission FROM role_permissions WHERE role = $1`
+	if err := r.db.SelectContext(ctx, &permissions, query, name); err != nil {
+		return nil, err
+	}
+	return permissions, nil
+}
+
+func replacePermissions(ctx context.Context, tx *sqlx.Tx, role string, permissions []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM role_permissions WHERE role = $1`, role); err != nil {
+		return err
+	}
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO role_permissions (role, permission)
+		SELECT $1, UNNEST($2::text[])
+		ON CONFLICT DO NOTHING
+	`
+	_, err := tx.ExecContext(ctx, query, role, pq.Array(permissions))
+	return err
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/rbac/domain"
+)
+
+// permissionCacheTTL başka bir instance'taki rol değişikliklerinin en fazla ne kadar süre fark edilmeyeceğini belirler.
+const permissionCacheTTL = time.Minute
+
+type RoleService interface {
+	ListRoles(ctx context.Context) ([]domain.Role, error)
+	GetRole(ctx context.Context, name string) (*domain.Role, error)
+	CreateRole(ctx context.Context, req *domain.CreateRoleRequest) (*domain.Role, error)
+	UpdateRole(ctx context.Context, name string, req *domain.UpdateRoleRequest) (*domain.Role, error)
+	DeleteRole(ctx context.Context, name string) error
+	ListPermissions(ctx context.Context) ([]domain.Permission, error)
+	HasPermission(ctx context.Context, role string, permission string) (bool, error)
+}
+
+type cachedPermissions struct {
+	permissions map[string]struct{}
+	loadedAt    time.Time
+}
+
+type roleService struct {
+	repo   domain.RoleRepository
+	logger logger.Logger
+
+	mu    sync.RWMutex
+	cache map[string]cachedPermissions
+}
+
+func NewRoleService(repo domain.RoleRepository, logger logger.Logger) RoleService {
+	return &roleService{
+		repo:   repo,
+		logger: logger,
+		cache:  make(map[string]cachedPermissions),
+	}
+}
+
+func (s *roleService) ListRoles(ctx context.Context) ([]domain.Role, error) {
+	roles, err := s.repo.GetAll(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list roles", err, nil)
+		return nil, err
+	}
+	return roles, nil
+}
+
+func (s *roleService) GetRole(ctx context.Context, name string) (*domain.Role, error) {
+	role, err := s.repo.GetByName(ctx, normalizeRoleName(name))
+	if err != nil {
+		s.logger.Error("Failed to get role", err, map[string]interface{}{
+			"role": name,
+		})
+		return nil, err
+	}
+	if role == nil {
+		return nil, domain.ErrRoleNotFound{}
+	}
+	return role, nil
+}
+
+func (s *roleService) CreateRole(ctx context.Context, req *domain.CreateRoleRequest) (*domain.Role, error) {
+	permissions, err := s.checkPermissions(ctx, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	role := &domain.Role{
+		Name:        normalizeRoleName(req.Name),
+		Description: strings.TrimSpace(req.Description),
+		Permissions: permissions,
+	}
+
+	if err := s.repo.Create(ctx, role); err != nil {
+		s.logger.Error("Failed to create role", err, map[string]interface{}{
+			"role": role.Name,
+		})
+		return nil, err
+	}
+
+	s.invalidate(role.Name)
+
+	s.logger.Info("Role created", map[string]interface{}{
+		"action":      "ROLE_CREATE",
+		"actor":       utils.GetUsernameFromContext(ctx),
+		"role":        role.Name,
+		"permissions": role.Permissions,
+	})
+
+	return role, nil
+}
+
+func (s *roleService) UpdateRole(ctx context.Context, name string, req *domain.UpdateRoleRequest) (*domain.Role, error) {
+	role, err := s.GetRole(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if role.Name == domain.AdminRole && req.Permissions != nil {
+		return nil, domain.ErrSystemRole{}
+	}
+
+	if req.Description != nil {
+		role.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.Permissions != nil {
+		permissions, err := s.checkPermissions(ctx, req.Permissions)
+		if err != nil {
+			return nil, err
+		}
+		role.Permissions = permissions
+	}
+
+	if err := s.repo.Update(ctx, role); err != nil {
+		s.logger.Error("Failed to update role", err, map[string]interface{}{
+			"role": role.Name,
+		})
+		return nil, err
+	}
+
+	s.invalidate(role.Name)
+
+	s.logger.Info("Role updated", map[string]interface{}{
+		"action":      "ROLE_UPDATE",
+		"actor":       utils.GetUsernameFromContext(ctx),
+		"role":        role.Name,
+		"permissions": role.Permissions,
+	})
+
+	return role, nil
+}
+
+func (s *roleService) DeleteRole(ctx context.Context, name string) error {
+	role, err := s.GetRole(ctx, name)
+	if err != nil {
+		return err
+	}
+	if role.IsSystem {
+		return domain.ErrSystemRole{}
+	}
+
+	if err := s.repo.Delete(ctx, role.Name); err != nil {
+		s.logger.Error("Failed to delete role", err, map[string]interface{}{
+			"role": role.Name,
+		})
+		return err
+	}
+
+	s.invalidate(role.Name)
+
+	s.logger.Info("Role deleted", map[string]interface{}{
+		"action": "ROLE_DELETE",
+		"actor":  utils.GetUsernameFromContext(ctx),
+		"role":   role.Name,
+	})
+
+	return nil
+}
+
+func (s *roleService) ListPermissions(ctx context.Context) ([]domain.Permission, error) {
+	permissions, err := s.repo.GetPermissions(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list permissions", err, nil)
+		return nil, err
+	}
+	return permissions, nil
+}
+
+func (s *roleService) HasPermission(ctx context.Context, role string, permission string) (bool, error) {
+	if role == "" {
+		return false, nil
+	}
+
+	s.mu.RLock()
+	entry, ok := s.cache[role]
+	s.mu.RUnlock()
+
+	if !ok || time.Since(entry.loadedAt) > permissionCacheTTL {
+		keys, err := s.repo.GetRolePermissions(ctx, role)
+		if err != nil {
+			s.logger.Error("Failed to load role permissions", err, map[string]interface{}{
+				"role": role,
+			})
+			return false, err
+		}
+
+		entry = cachedPermissions{
+			permissions: make(map[string]struct{}, len(keys)),
+			loadedAt:    time.Now(),
+		}
+		for _, key := range keys {
+			entry.permissions[key] = struct{}{}
+		}
+
+		s.mu.Lock()
+		s.cache[role] = entry
+		s.mu.Unlock()
+	}
+
+	_, allowed := entry.permissions[permission]
+	return allowed, nil
+}
+
+// checkPermissions bilinmeyen izinleri reddeder ve tekrarları ayıklanmış listeyi döner.
+func (s *roleService) checkPermissions(ctx context.Context, keys []string) ([]string, error) {
+	known, err := s.repo.GetPermissions(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list permissions", err, nil)
+		return nil, err
+	}
+
+	valid := make(map[string]struct{}, len(known))
+	for _, permission := range known {
+		valid[permission.Key] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(keys))
+	permissions := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := valid[key]; !ok {
+			return nil, domain.ErrUnknownPermission{Key: key}
+		}
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		permissions = append(permissions, key)
+	}
+	return permissions, nil
+}
+
+func (s *roleService) invalidate(role string) {
+	s.mu.Lock()
+	delete(s.cache, role)
+	s.mu.Unlock()
+}
+
+func normalizeRoleName(name string) string {
+	return strings.ToUpper(strings.TrimSpace(name))
+}
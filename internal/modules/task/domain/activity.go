@@ -1,6 +1,10 @@
 package domain
 
 import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,15 +15,134 @@ type ActivityAction string
 const (
 	ActivityTaskCreated       ActivityAction = "task_created"
 	ActivityAssignmentAdded   ActivityAction = "assignment_added"
+	ActivityAssignmentRemoved ActivityAction = "assignment_removed"
 	ActivityScopeAdded        ActivityAction = "scope_added"
+	ActivityScopeRemoved      ActivityAction = "scope_removed"
 	ActivityTaskStatusChanged ActivityAction = "task_status_changed"
+
+	ActivityTaskTitleChanged       ActivityAction = "task_title_changed"
+	ActivityTaskDescriptionChanged ActivityAction = "task_description_changed"
+	ActivityTaskPriorityChanged    ActivityAction = "task_priority_changed"
+	ActivityTaskDueDateChanged     ActivityAction = "task_due_date_changed"
+	ActivityTaskEffortChanged      ActivityAction = "task_effort_changed"
+	ActivityTaskDeleted            ActivityAction = "task_deleted"
+	ActivityTaskRestored           ActivityAction = "task_restored"
+	ActivityTagAdded               ActivityAction = "tag_added"
+	ActivityTagRemoved             ActivityAction = "tag_removed"
+	ActivityTagMerged              ActivityAction = "tag_merged"
+	ActivityCommentAdded           ActivityAction = "comment_added"
+	ActivityCommentEdited          ActivityAction = "comment_edited"
+	ActivityCommentDeleted         ActivityAction = "comment_deleted"
+	ActivityParentChanged          ActivityAction = "parent_changed"
+	ActivityDependencyAdded        ActivityAction = "dependency_added"
+	ActivityDependencyRemoved      ActivityAction = "dependency_removed"
+	ActivityAssignmentReassigned   ActivityAction = "assignment_reassigned"
+	ActivityTeamAssigned           ActivityAction = "team_assigned"
+	ActivityTeamUnassigned         ActivityAction = "team_unassigned"
 )
 
 type Activity struct {
-	ID     uuid.UUID      `json:"id" db:"id"`
-	TaskID uuid.UUID      `json:"task_id" db:"task_id"`
-	UserID uuid.UUID      `json:"user_id" db:"user_id"`
-	Action ActivityAction `json:"action" db:"action"`
+	ID      uuid.UUID       `json:"id" db:"id"`
+	TaskID  uuid.UUID       `json:"task_id" db:"task_id"`
+	UserID  uuid.UUID       `json:"user_id" db:"user_id"`
+	Action  ActivityAction  `json:"action" db:"action"`
+	Changes ActivityChanges `json:"changes" db:"changes"`
 
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 }
+
+// FieldChange bir alanın değişiklik öncesi ve sonrası değeridir.
+type FieldChange struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+// ActivityChanges task_activities.changes JSONB kolonunda saklanır. Fields alan bazlı
+// farkları, Metadata ise aksiyona özgü ek bilgileri (ör. tag adı, atanan kullanıcı) taşır.
+type ActivityChanges struct {
+	Fields   map[string]FieldChange `json:"fields,omitempty"`
+	Metadata map[string]any         `json:"metadata,omitempty"`
+}
+
+func FieldChanges(field string, from, to any) ActivityChanges {
+	return ActivityChanges{Fields: map[string]FieldChange{field: {From: from, To: to}}}
+}
+
+func ActivityMetadata(metadata map[string]any) ActivityChanges {
+	return ActivityChanges{Metadata: metadata}
+}
+
+func (c ActivityChanges) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+func (c *ActivityChanges) Scan(src any) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		*c = ActivityChanges{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported type for ActivityChanges: %T", src)
+	}
+	return json.Unmarshal(data, c)
+}
+
+type ActivityFilter struct {
+	Actions []ActivityAction `json:"action" validate:"omitempty,dive,max=50"`
+	Cursor  string           `json:"cursor"`
+	Limit   int              `json:"limit" validate:"omitempty,min=1,max=100"`
+
+	// After, Cursor alanının service katmanında çözülmüş halidir.
+	After *ActivityCursor `json:"-"`
+}
+
+func (f *ActivityFilter) Normalize() {
+	if f.Limit <= 0 {
+		f.Limit = DefaultTaskPageSize
+	}
+	if f.Limit > MaxTaskPageSize {
+		f.Limit = MaxTaskPageSize
+	}
+}
+
+// ActivityView bir aktivite kaydını okunabilir özetiyle birlikte sunar.
+type ActivityView struct {
+	Activity
+	Username string `json:"username"`
+	Summary  string `json:"summary"`
+}
+
+type ActivityPage struct {
+	Items      []ActivityView `json:"items"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+// ActivityCursor aktiviteler created_at DESC, id DESC sıralı olduğundan son satırın
+// zamanını ve ID'sini taşır.
+type ActivityCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"id"`
+}
+
+func (c *ActivityCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeActivityCursor(s string) (*ActivityCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor{}
+	}
+
+	cursor := &ActivityCursor{}
+	if err := json.Unmarshal(data, cursor); err != nil || cursor.ID == uuid.Nil {
+		return nil, ErrInvalidCursor{}
+	}
+	return cursor, nil
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var activityPhrases = map[ActivityAction]string{
+	ActivityTaskCreated:            "task'ı oluşturdu",
+	ActivityAssignmentAdded:        "task'a kullanıcı atadı",
+	ActivityAssignmentRemoved:      "task'tan kullanıcı atamasını kaldırdı",
+	ActivityScopeAdded:             "atamaya scope ekledi",
+	ActivityScopeRemoved:           "atamadan scope kaldırdı",
+	ActivityTaskStatusChanged:      "task durumunu değiştirdi",
+	ActivityTaskTitleChanged:       "task başlığını değiştirdi",
+	ActivityTaskDescriptionChanged: "task açıklamasını güncelledi",
+	ActivityTaskPriorityChanged:    "task önceliğini değiştirdi",
+	ActivityTaskDueDateChanged:     "bitiş tarihini değiştirdi",
+	ActivityTaskEffortChanged:      "tahmini eforu değiştirdi",
+	ActivityTaskDeleted:            "task'ı sildi",
+	ActivityTaskRestored:           "task'ı geri yükledi",
+	ActivityTagAdded:               "task'a tag ekledi",
+	ActivityTagRemoved:             "task'tan tag kaldırdı",
+	ActivityTagMerged:              "task'ın tag'ini başka bir tag ile birleştirdi",
+	ActivityCommentAdded:           "yorum yazdı",
+	ActivityCommentEdited:          "yorumunu düzenledi",
+	ActivityCommentDeleted:         "yorumunu sildi",
+	ActivityParentChanged:          "üst görevi değiştirdi",
+	ActivityDependencyAdded:        "engelleyici görev ekledi",
+	ActivityDependencyRemoved:      "engelleyici görevi kaldırdı",
+	ActivityAssignmentReassigned:   "atamayı başka bir kullanıcıya devretti",
+	ActivityTeamAssigned:           "task'ı takıma atadı",
+	ActivityTeamUnassigned:         "task'ın takım atamasını kaldırdı",
+}
+
+// SummarizeActivity aktivite için "ahmet task durumunu değiştirdi (status: todo → done)"
+// biçiminde okunabilir bir özet üretir.
+func SummarizeActivity(activity *Activity, actor string) string {
+	phrase, ok := activityPhrases[activity.Action]
+	if !ok {
+		phrase = string(activity.Action)
+	}
+
+	details := []string{}
+
+	fields := make([]string, 0, len(activity.Changes.Fields))
+	for field := range activity.Changes.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		change := activity.Changes.Fields[field]
+		details = append(details, fmt.Sprintf("%s: %s → %s", field, formatChangeValue(change.From), formatChangeValue(change.To)))
+	}
+
+	keys := make([]string, 0, len(activity.Changes.Metadata))
+	for key := range activity.Changes.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		details = append(details, fmt.Sprintf("%s: %s", key, formatChangeValue(activity.Changes.Metadata[key])))
+	}
+
+	summary := actor + " " + phrase
+	if len(details) > 0 {
+		summary += " (" + strings.Join(details, ", ") + ")"
+	}
+	return summary
+}
+
+func formatChangeValue(v any) string {
+	if v == nil {
+		return "boş"
+	}
+	s := fmt.Sprintf("%v", v)
+	if s == "" {
+		return "boş"
+	}
+	if len([]rune(s)) > 60 {
+		return string([]rune(s)[:57]) + "..."
+	}
+	return s
+}
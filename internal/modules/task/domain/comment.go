@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type Comment struct {
+	ID       uuid.UUID  `json:"id" db:"id"`
+	TaskID   uuid.UUID  `json:"task_id" db:"task_id"`
+	ParentID *uuid.UUID `json:"parent_id" db:"parent_id"`
+	AuthorID uuid.UUID  `json:"author_id" db:"author_id"`
+	Body     string     `json:"body" db:"body"`
+	// Mentions yorumda @username ile anılan ve çözümlenebilen kullanıcıların ID'leridir.
+	Mentions pq.StringArray `json:"mentions" db:"mentions"`
+
+	EditedAt  *time.Time `json:"edited_at" db:"edited_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
+
+	Replies []Comment `json:"replies" db:"-"`
+}
+
+// CommentEdit bir yorumun düzenlenmeden önceki halini saklar.
+type CommentEdit struct {
+	ID        uuid.UUID `json:"id" db:"id"`
+	CommentID uuid.UUID `json:"comment_id" db:"comment_id"`
+	Body      string    `json:"body" db:"body"`
+	EditedBy  uuid.UUID `json:"edited_by" db:"edited_by"`
+	EditedAt  time.Time `json:"edited_at" db:"edited_at"`
+}
+
+type CreateCommentRequest struct {
+	Body     string `json:"body" validate:"required,max=5000"`
+	ParentID string `json:"parent_id" validate:"omitempty,uuid"`
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body" validate:"required,max=5000"`
+}
+
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@])@([A-Za-z0-9_.\-]{1,50})`)
+
+// ParseMentions yorum metnindeki @username ifadelerini sırayı koruyarak ve tekilleştirerek döner.
+func ParseMentions(body string) []string {
+	seen := map[string]bool{}
+	usernames := []string{}
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		username := strings.TrimRight(match[1], ".-")
+		if username == "" || seen[username] {
+			continue
+		}
+		seen[username] = true
+		usernames = append(usernames, username)
+	}
+	return usernames
+}
+
+type ErrCommentNotFound struct{}
+
+func (e ErrCommentNotFound) Error() string {
+	return "comment not found"
+}
+
+type ErrCommentForbidden struct{}
+
+func (e ErrCommentForbidden) Error() string {
+	return "only the author or an admin can modify this comment"
+}
+
+type ErrInvalidParentComment struct{}
+
+func (e ErrInvalidParentComment) Error() string {
+	return "parent comment does not belong to this task"
+}
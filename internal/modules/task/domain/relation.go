@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskDependency BlockerID task'ı tamamlanmadan BlockedID task'ının tamamlanamayacağını ifade eder.
+type TaskDependency struct {
+	BlockerID uuid.UUID `json:"blocker_id" db:"blocker_id"`
+	BlockedID uuid.UUID `json:"blocked_id" db:"blocked_id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// TaskRef bağımlılık listelerinde kullanılan özet task bilgisidir.
+type TaskRef struct {
+	ID     uuid.UUID  `json:"id" db:"id"`
+	Title  string     `json:"title" db:"title"`
+	Status TaskStatus `json:"status" db:"status"`
+	IsOpen bool       `json:"is_open" db:"is_open"`
+}
+
+type TaskDependencies struct {
+	BlockedBy []TaskRef `json:"blocked_by"`
+	Blocks    []TaskRef `json:"blocks"`
+}
+
+// TaskInclude GET /api/tasks/{id} için include query parametresinin çözülmüş halidir.
+type TaskInclude struct {
+	Subtasks     bool
+	Dependencies bool
+}
+
+// SetParentRequest task'ı başka bir task'ın alt görevi yapar; parent_id null ise task kök olur.
+type SetParentRequest struct {
+	ParentID *string `json:"parent_id" validate:"omitnil,uuid"`
+}
+
+type AddDependencyRequest struct {
+	BlockerID string `json:"blocker_id" validate:"required,uuid"`
+}
+
+type ErrTaskCycle struct{}
+
+func (e ErrTaskCycle) Error() string {
+	return "this link would create a cycle"
+}
+
+type ErrDependencyNotFound struct{}
+
+func (e ErrDependencyNotFound) Error() string {
+	return "dependency not found"
+}
+
+type ErrParentTaskNotFound struct{}
+
+func (e ErrParentTaskNotFound) Error() string {
+	return "parent task not found"
+}
+
+// ErrTaskBlocked açık engelleyicisi veya bitmemiş alt görevi olan bir task tamamlanmak
+// istendiğinde döner.
+type ErrTaskBlocked struct {
+	OpenBlockers int
+	OpenSubtasks int
+}
+
+func (e ErrTaskBlocked) Error() string {
+	return fmt.Sprintf("task has %d open blocker(s) and %d unfinished subtask(s)", e.OpenBlockers, e.OpenSubtasks)
+}
@@ -3,42 +3,132 @@ package domain
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
 
 type TaskRepository interface {
-	Create(ctx context.Context, task *Task) error
+	Create(ctx context.Context, tx *sqlx.Tx, task *Task) error
 	GetByID(ctx context.Context, taskID string) (*Task, error)
-	List(ctx context.Context) ([]Task, error)
+	// GetDeletedByID soft-delete edilmiş task'ı döner; task yoksa veya silinmemişse nil döner.
+	GetDeletedByID(ctx context.Context, taskID string) (*Task, error)
+	List(ctx context.Context, filter *TaskFilter) ([]Task, error)
+	Count(ctx context.Context, filter *TaskFilter) (int, error)
 
-	UpdateStatus(ctx context.Context, taskID string, status TaskStatus) error
-	BeginTx(ctx context.Context) (*sqlx.Tx, error)
+	Update(ctx context.Context, tx *sqlx.Tx, task *Task) error
+	UpdateStatus(ctx context.Context, tx *sqlx.Tx, taskID string, from, to TaskStatus) error
+	SoftDelete(ctx context.Context, tx *sqlx.Tx, taskID string) error
+	Restore(ctx context.Context, tx *sqlx.Tx, taskID string) error
+}
+
+type WorkflowRepository interface {
+	GetWorkflow(ctx context.Context) (*Workflow, error)
+	GetState(ctx context.Context, key TaskStatus) (*WorkflowState, error)
+	GetInitialState(ctx context.Context) (*WorkflowState, error)
+	GetTransition(ctx context.Context, from, to TaskStatus) (*WorkflowTransition, error)
+
+	CreateState(ctx context.Context, state *WorkflowState) error
+	DeleteState(ctx context.Context, key TaskStatus) error
+	CreateTransition(ctx context.Context, transition *WorkflowTransition) error
+	DeleteTransition(ctx context.Context, transitionID string) error
 }
 
 type AssignmentRepository interface {
 	Create(ctx context.Context, tx *sqlx.Tx, assignment *TaskAssignment) error
-	Delete(ctx context.Context, assignmentID string) error
+	Delete(ctx context.Context, tx *sqlx.Tx, assignmentID string) error
+	GetByID(ctx context.Context, assignmentID string) (*TaskAssignment, error)
 	GetByTask(ctx context.Context, taskID string) ([]TaskAssignment, error)
-	BeginTx(ctx context.Context) (*sqlx.Tx, error)
+	// GetByTaskTx atamaları verilen transaction içinde okur; transaction'ın kendi
+	// değişikliklerini görür.
+	GetByTaskTx(ctx context.Context, tx *sqlx.Tx, taskID string) ([]TaskAssignment, error)
+
+	// ListOpenByUser kullanıcının silinmemiş ve final durumda olmayan task'lardaki
+	// atamalarını kilitleyerek döner.
+	ListOpenByUser(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID) ([]OpenAssignment, error)
+
+	Reassign(ctx context.Context, tx *sqlx.Tx, assignmentID, userID uuid.UUID) error
+}
+
+type TeamAssignmentRepository interface {
+	// Create task takıma zaten atanmışsa ErrTeamAlreadyAssigned döner.
+	Create(ctx context.Context, tx *sqlx.Tx, assignment *TeamAssignment) error
+	GetByID(ctx context.Context, assignmentID string) (*TeamAssignment, error)
+	GetByTask(ctx context.Context, taskID string) ([]TeamAssignment, error)
+	Delete(ctx context.Context, tx *sqlx.Tx, assignmentID string) error
 }
 
 type ScopeRepository interface {
-	AddToAssignment(ctx context.Context, assignmentID string, scopeID string) error
+	AddToAssignment(ctx context.Context, tx *sqlx.Tx, assignmentID string, scopeID string) error
 
-	RemoveFromAssignment(ctx context.Context, assignmentID string, scopeID string) error
+	RemoveFromAssignment(ctx context.Context, tx *sqlx.Tx, assignmentID string, scopeID string) error
 
 	GetByAssignment(ctx context.Context, assignmentID string) ([]Scope, error)
+
+	GetByAssignments(ctx context.Context, assignmentIDs []string) (map[string][]Scope, error)
 }
 
 type ScopeLookupRepository interface {
 	GetAll(ctx context.Context) ([]Scope, error)
 	GetByID(ctx context.Context, scopeID string) (*Scope, error)
+
+	Create(ctx context.Context, scope *Scope) error
+	Update(ctx context.Context, scope *Scope) error
+	Delete(ctx context.Context, scopeID string) error
+}
+
+type TagRepository interface {
+	GetAll(ctx context.Context) ([]Tag, error)
+	GetByID(ctx context.Context, tagID string) (*Tag, error)
+	Create(ctx context.Context, tag *Tag) error
+	Rename(ctx context.Context, tagID string, name string) error
+	Delete(ctx context.Context, tagID string) error
+
+	// Merge kaynak tag'in task bağlantılarını hedef tag'e taşır, kaynak tag'i siler ve
+	// kaynak tag'e bağlı olan task'ların ID'lerini döner.
+	Merge(ctx context.Context, tx *sqlx.Tx, sourceID string, targetID string) ([]uuid.UUID, error)
+
+	AddToTask(ctx context.Context, tx *sqlx.Tx, taskID string, tagID string) error
+	RemoveFromTask(ctx context.Context, tx *sqlx.Tx, taskID string, tagID string) error
+	GetByTask(ctx context.Context, taskID string) ([]Tag, error)
+}
+
+// TaskRelationRepository alt görev hiyerarşisini ve task bağımlılıklarını yönetir.
+type TaskRelationRepository interface {
+	// LockGraph eşzamanlı yazmaların döngü kontrolünü atlatmaması için transaction
+	// süresince hiyerarşi ve bağımlılık grafiğini kilitler.
+	LockGraph(ctx context.Context, tx *sqlx.Tx) error
+
+	SetParent(ctx context.Context, tx *sqlx.Tx, taskID string, parentID *string) error
+	// IsDescendant candidateID, ancestorID'nin alt ağacındaysa (kendisi dahil) true döner.
+	IsDescendant(ctx context.Context, tx *sqlx.Tx, ancestorID string, candidateID string) (bool, error)
+	GetDescendants(ctx context.Context, rootID string) ([]Task, error)
+	CountOpenSubtasks(ctx context.Context, taskID string) (int, error)
+
+	CreateDependency(ctx context.Context, tx *sqlx.Tx, dependency *TaskDependency) error
+	DeleteDependency(ctx context.Context, tx *sqlx.Tx, blockerID string, blockedID string) error
+	// HasPath fromID'den toID'ye "engeller" kenarları üzerinden bir yol varsa true döner.
+	HasPath(ctx context.Context, tx *sqlx.Tx, fromID string, toID string) (bool, error)
+	GetBlockers(ctx context.Context, taskID string) ([]TaskRef, error)
+	GetBlocking(ctx context.Context, taskID string) ([]TaskRef, error)
+	CountOpenBlockers(ctx context.Context, taskID string) (int, error)
+}
+
+type CommentRepository interface {
+	Create(ctx context.Context, tx *sqlx.Tx, comment *Comment) error
+	GetByID(ctx context.Context, commentID string) (*Comment, error)
+	GetByTask(ctx context.Context, taskID string) ([]Comment, error)
+	Update(ctx context.Context, tx *sqlx.Tx, comment *Comment) error
+	SoftDelete(ctx context.Context, tx *sqlx.Tx, commentID string) error
+
+	CreateEdit(ctx context.Context, tx *sqlx.Tx, edit *CommentEdit) error
+	GetEdits(ctx context.Context, commentID string) ([]CommentEdit, error)
 }
 
 type ActivityRepository interface {
-	Create(ctx context.Context, activity *Activity) error
+	// Create aktiviteyi verilen transaction içinde yazar; tx nil ise doğrudan veritabanına yazar.
+	Create(ctx context.Context, tx *sqlx.Tx, activity *Activity) error
 
-	GetByTask(ctx context.Context, taskID string) ([]Activity, error)
+	GetByTask(ctx context.Context, taskID string, filter *ActivityFilter) ([]Activity, error)
 
-	GetByUser(ctx context.Context, userID string) ([]Activity, error)
+	GetByUser(ctx context.Context, userID string, filter *ActivityFilter) ([]Activity, error)
 }
@@ -1,8 +1,64 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"fmt"
 
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Scope bir atamaya bağlanarak atanan kullanıcının task üzerinde yapabileceklerini daraltır.
+// Hiç izni olmayan bir scope (ör. "read-only") kullanıcıyı sadece okuma yetkisiyle bırakır.
 type Scope struct {
-	ID   uuid.UUID `json:"id" db:"id"`
-	Name string    `json:"name" db:"name"`
+	ID          uuid.UUID      `json:"id" db:"id"`
+	Name        string         `json:"name" db:"name"`
+	Permissions pq.StringArray `json:"permissions" db:"permissions"`
+}
+
+const (
+	ScopePermissionStatusUpdate = "status:update"
+	ScopePermissionTaskEdit     = "task:edit"
+)
+
+func (s Scope) Allows(permission string) bool {
+	for _, p := range s.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+type CreateScopeRequest struct {
+	Name        string   `json:"name" validate:"required,max=100"`
+	Permissions []string `json:"permissions" validate:"dive,oneof=status:update task:edit"`
+}
+
+type UpdateScopeRequest struct {
+	Name        *string  `json:"name" validate:"omitnil,min=1,max=100"`
+	Permissions []string `json:"permissions" validate:"omitempty,dive,oneof=status:update task:edit"`
+}
+
+type AddAssignmentScopeRequest struct {
+	ScopeID string `json:"scope_id" validate:"required,uuid"`
+}
+
+type ErrScopeNotFound struct{}
+
+func (e ErrScopeNotFound) Error() string {
+	return "scope not found"
+}
+
+type ErrAssignmentNotFound struct{}
+
+func (e ErrAssignmentNotFound) Error() string {
+	return "assignment not found"
+}
+
+type ErrScopeDenied struct {
+	Permission string
+}
+
+func (e ErrScopeDenied) Error() string {
+	return fmt.Sprintf("assignment scopes do not allow %s", e.Permission)
 }
@@ -3,6 +3,43 @@ package domain
 import "github.com/google/uuid"
 
 type Tag struct {
-	ID   uuid.UUID `json:"id" db:"id"`
-	Name string    `json:"name" db:"name"`
+	ID         uuid.UUID `json:"id" db:"id"`
+	Name       string    `json:"name" db:"name"`
+	UsageCount int       `json:"usage_count" db:"usage_count"`
+}
+
+type TagMatchMode string
+
+const (
+	TagMatchAny TagMatchMode = "any"
+	TagMatchAll TagMatchMode = "all"
+)
+
+type CreateTagRequest struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+type RenameTagRequest struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+// MergeTagRequest kaynak tag'i (path'teki id) hedef tag'e birleştirir; kaynak tag silinir.
+type MergeTagRequest struct {
+	TargetID string `json:"target_id" validate:"required,uuid"`
+}
+
+type AddTaskTagRequest struct {
+	TagID string `json:"tag_id" validate:"required,uuid"`
+}
+
+type ErrTagNotFound struct{}
+
+func (e ErrTagNotFound) Error() string {
+	return "tag not found"
+}
+
+type ErrTagMergeSelf struct{}
+
+func (e ErrTagMergeSelf) Error() string {
+	return "a tag cannot be merged into itself"
 }
@@ -3,9 +3,12 @@ package domain
 import (
 	"time"
 
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/stype"
 	"github.com/google/uuid"
 )
 
+// TaskStatus workflow_states tablosundaki bir durumun anahtarıdır. Aşağıdaki değerler
+// varsayılan workflow ile gelir; yeni durumlar kod değişikliği olmadan eklenebilir.
 type TaskStatus string
 
 const (
@@ -14,24 +17,65 @@ const (
 	TaskStatusDone       TaskStatus = "done"
 )
 
+type TaskPriority string
+
+const (
+	TaskPriorityLow    TaskPriority = "low"
+	TaskPriorityMedium TaskPriority = "medium"
+	TaskPriorityHigh   TaskPriority = "high"
+	TaskPriorityUrgent TaskPriority = "urgent"
+)
+
 type Task struct {
-	ID        uuid.UUID  `json:"id" db:"id"`
-	Title     string     `json:"title" db:"title"`
-	Status    TaskStatus `json:"status" db:"status"`
-	CreatedBy uuid.UUID  `json:"created_by" db:"created_by"`
+	ID          uuid.UUID    `json:"id" db:"id"`
+	Title       string       `json:"title" db:"title"`
+	Description string       `json:"description" db:"description"`
+	Status      TaskStatus   `json:"status" db:"status"`
+	Priority    TaskPriority `json:"priority" db:"priority"`
+	DueDate     *time.Time   `json:"due_date" db:"due_date"`
+	// EstimatedEffort dakika cinsinden tahmini efordur.
+	EstimatedEffort *int       `json:"estimated_effort" db:"estimated_effort"`
+	CreatedBy       uuid.UUID  `json:"created_by" db:"created_by"`
+	ParentID        *uuid.UUID `json:"parent_id" db:"parent_id"`
+
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
 
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	// Subtasks ve Dependencies sadece include parametresiyle istendiğinde doldurulur.
+	Subtasks     []Task            `json:"subtasks,omitempty" db:"-"`
+	Dependencies *TaskDependencies `json:"dependencies,omitempty" db:"-"`
 }
 
 type CreateTaskRequest struct {
-	Title string `json:"title" validate:"required,min=1,max=255"`
+	Title           string       `json:"title" validate:"required,min=1,max=255"`
+	Description     string       `json:"description" validate:"max=5000"`
+	Priority        TaskPriority `json:"priority" validate:"omitempty,oneof=low medium high urgent"`
+	DueDate         *time.Time   `json:"due_date"`
+	EstimatedEffort *int         `json:"estimated_effort" validate:"omitempty,min=0,max=100000"`
+	ParentID        string       `json:"parent_id" validate:"omitempty,uuid"`
+}
+
+// UpdateTaskRequest kısmi güncelleme isteğidir; gönderilmeyen alanlar değişmez.
+// due_date ve estimated_effort null gönderilerek temizlenebilir.
+type UpdateTaskRequest struct {
+	Title           *string                   `json:"title" validate:"omitnil,min=1,max=255"`
+	Description     *string                   `json:"description" validate:"omitempty,max=5000"`
+	Priority        *TaskPriority             `json:"priority" validate:"omitempty,oneof=low medium high urgent"`
+	DueDate         stype.Nullable[time.Time] `json:"due_date"`
+	EstimatedEffort stype.Nullable[int]       `json:"estimated_effort" validate:"omitempty,min=0,max=100000"`
 }
 
 type UpdateStatusRequest struct {
-	Status TaskStatus `json:"status" validate:"required,oneof=todo in_progress done"`
+	Status TaskStatus `json:"status" validate:"required,max=20"`
 }
 
 type AssignTaskRequest struct {
 	UserID string `json:"user_id" validate:"required,uuid"`
 }
+
+type ErrTaskNotFound struct{}
+
+func (e ErrTaskNotFound) Error() string {
+	return "task not found"
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TaskSortField string
+
+const (
+	TaskSortCreatedAt TaskSortField = "created_at"
+	TaskSortUpdatedAt TaskSortField = "updated_at"
+	TaskSortTitle     TaskSortField = "title"
+	TaskSortStatus    TaskSortField = "status"
+)
+
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+const (
+	DefaultTaskPageSize = 20
+	MaxTaskPageSize     = 100
+)
+
+// TaskFilter GET /api/tasks query parametrelerinin parse edilmiş halidir.
+type TaskFilter struct {
+	Statuses    []TaskStatus `json:"status" validate:"omitempty,dive,max=20"`
+	CreatedBy   *uuid.UUID   `json:"created_by"`
+	Assignee    *uuid.UUID   `json:"assignee"`
+	Team        *uuid.UUID   `json:"team"`
+	CreatedFrom *time.Time   `json:"created_from"`
+	CreatedTo   *time.Time   `json:"created_to"`
+	UpdatedFrom *time.Time   `json:"updated_from"`
+	UpdatedTo   *time.Time   `json:"updated_to"`
+	Title       string       `json:"q" validate:"omitempty,max=255"`
+	Tags        []string     `json:"tag" validate:"omitempty,dive,max=50"`
+	TagMode     TagMatchMode `json:"tag_mode" validate:"omitempty,oneof=any all"`
+
+	Sort         TaskSortField `json:"sort" validate:"omitempty,oneof=created_at updated_at title status"`
+	Order        SortOrder     `json:"order" validate:"omitempty,oneof=asc desc"`
+	Cursor       string        `json:"cursor"`
+	Limit        int           `json:"limit" validate:"omitempty,min=1,max=100"`
+	IncludeTotal bool          `json:"include_total"`
+
+	// After, Cursor alanının service katmanında çözülmüş halidir.
+	After *TaskCursor `json:"-"`
+}
+
+// Normalize boş bırakılan sıralama ve sayfa boyutu alanlarını varsayılanlarla doldurur.
+func (f *TaskFilter) Normalize() {
+	if f.Sort == "" {
+		f.Sort = TaskSortCreatedAt
+	}
+	if f.Order == "" {
+		f.Order = SortDesc
+	}
+	if f.Limit <= 0 {
+		f.Limit = DefaultTaskPageSize
+	}
+	if f.Limit > MaxTaskPageSize {
+		f.Limit = MaxTaskPageSize
+	}
+}
+
+type TaskPage struct {
+	Items      []Task `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	Total      *int   `json:"total,omitempty"`
+}
+
+// TaskCursor keyset pagination için son görülen satırın sıralama değerini ve ID'sini taşır.
+// İstemciye base64 ile kodlanmış opak bir string olarak verilir.
+type TaskCursor struct {
+	Sort  TaskSortField `json:"s"`
+	Order SortOrder     `json:"o"`
+	Value string        `json:"v"`
+	ID    uuid.UUID     `json:"id"`
+}
+
+func NewTaskCursor(task *Task, sort TaskSortField, order SortOrder) *TaskCursor {
+	cursor := &TaskCursor{Sort: sort, Order: order, ID: task.ID}
+	switch sort {
+	case TaskSortUpdatedAt:
+		cursor.Value = task.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case TaskSortTitle:
+		cursor.Value = task.Title
+	case TaskSortStatus:
+		cursor.Value = string(task.Status)
+	default:
+		cursor.Value = task.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return cursor
+}
+
+func (c *TaskCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeTaskCursor(s string) (*TaskCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor{}
+	}
+
+	cursor := &TaskCursor{}
+	if err := json.Unmarshal(data, cursor); err != nil || cursor.ID == uuid.Nil {
+		return nil, ErrInvalidCursor{}
+	}
+	return cursor, nil
+}
+
+type ErrInvalidCursor struct{}
+
+func (e ErrInvalidCursor) Error() string {
+	return "invalid or mismatched cursor"
+}
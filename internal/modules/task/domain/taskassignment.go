@@ -11,4 +11,15 @@ type TaskAssignment struct {
 	TaskID    uuid.UUID `json:"task_id" db:"task_id"`
 	UserID    uuid.UUID `json:"user_id" db:"user_id"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
+
+	// TeamID atama bir takım liderinin dağıtımıyla oluştuysa takımı gösterir.
+	TeamID *uuid.UUID `json:"team_id,omitempty" db:"team_id"`
+
+	Scopes []Scope `json:"scopes" db:"-"`
+}
+
+// OpenAssignment final olmayan bir task'taki atamayı task başlığıyla birlikte taşır.
+type OpenAssignment struct {
+	TaskAssignment
+	TaskTitle string `db:"task_title"`
 }
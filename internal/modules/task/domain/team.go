@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TeamProvider team modülünün task modülüne açtığı takım bilgisidir.
+type TeamProvider interface {
+	// GetTeam takım bulunamazsa nil, nil döner. Members sadece aktif kullanıcıları içerir.
+	GetTeam(ctx context.Context, teamID uuid.UUID) (*TeamInfo, error)
+}
+
+type TeamInfo struct {
+	ID      uuid.UUID
+	Name    string
+	Members []TeamMemberInfo
+}
+
+type TeamMemberInfo struct {
+	UserID   uuid.UUID
+	Username string
+	Email    string
+	IsLead   bool
+}
+
+// Member kullanıcı takımın aktif bir üyesi değilse nil döner.
+func (t *TeamInfo) Member(userID uuid.UUID) *TeamMemberInfo {
+	for i := range t.Members {
+		if t.Members[i].UserID == userID {
+			return &t.Members[i]
+		}
+	}
+	return nil
+}
+
+// TeamAssignment bir task'ın takıma atanmasıdır. Takım lideri task'ı üyelere dağıttığında
+// oluşan kullanıcı atamaları TaskAssignment.TeamID ile bu takıma bağlanır.
+type TeamAssignment struct {
+	ID         uuid.UUID `json:"id" db:"id"`
+	TaskID     uuid.UUID `json:"task_id" db:"task_id"`
+	TeamID     uuid.UUID `json:"team_id" db:"team_id"`
+	TeamName   string    `json:"team_name" db:"team_name"`
+	AssignedBy uuid.UUID `json:"assigned_by" db:"assigned_by"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+type AssignTeamRequest struct {
+	TeamID string `json:"team_id" validate:"required,uuid"`
+}
+
+type DistributeTaskRequest struct {
+	UserID string `json:"user_id" validate:"required,uuid"`
+}
+
+type ErrTeamNotFound struct{}
+
+func (e ErrTeamNotFound) Error() string {
+	return "team not found"
+}
+
+type ErrTeamAssignmentNotFound struct{}
+
+func (e ErrTeamAssignmentNotFound) Error() string {
+	return "team assignment not found"
+}
+
+type ErrTeamAlreadyAssigned struct{}
+
+func (e ErrTeamAlreadyAssigned) Error() string {
+	return "task is already assigned to the team"
+}
+
+type ErrNotTeamLead struct{}
+
+func (e ErrNotTeamLead) Error() string {
+	return "only team leads can distribute team tasks"
+}
+
+type ErrNotTeamMember struct{}
+
+func (e ErrNotTeamMember) Error() string {
+	return "user is not an active member of the team"
+}
+
+type ErrAlreadyAssigned struct{}
+
+func (e ErrAlreadyAssigned) Error() string {
+	return "user is already assigned to the task"
+}
@@ -1,11 +1,16 @@
 package domain
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 )
 
 type UserProvider interface {
-	GetUserByID(userID uuid.UUID) (*UserInfo, error)
+	GetUserByID(ctx context.Context, userID uuid.UUID) (*UserInfo, error)
+
+	// GetUserByUsername kullanıcı bulunamazsa nil, nil döner.
+	GetUserByUsername(ctx context.Context, username string) (*UserInfo, error)
 }
 
 type UserInfo struct {
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// WorkflowState task'ların alabileceği bir durumdur. Key, tasks.status kolonunda saklanan değerdir.
+type WorkflowState struct {
+	Key       TaskStatus `json:"key" db:"key"`
+	Name      string     `json:"name" db:"name"`
+	IsInitial bool       `json:"is_initial" db:"is_initial"`
+	IsFinal   bool       `json:"is_final" db:"is_final"`
+	Position  int        `json:"position" db:"position"`
+}
+
+// WorkflowTransition iki durum arasındaki izinli geçiştir.
+// RequiredRole doluysa geçişi sadece o role sahip kullanıcılar yapabilir.
+type WorkflowTransition struct {
+	ID           uuid.UUID  `json:"id" db:"id"`
+	FromState    TaskStatus `json:"from_state" db:"from_state"`
+	ToState      TaskStatus `json:"to_state" db:"to_state"`
+	RequiredRole *string    `json:"required_role" db:"required_role"`
+}
+
+type Workflow struct {
+	States      []WorkflowState      `json:"states"`
+	Transitions []WorkflowTransition `json:"transitions"`
+}
+
+type CreateWorkflowStateRequest struct {
+	Key       string `json:"key" validate:"required,max=20,slug"`
+	Name      string `json:"name" validate:"required,max=100"`
+	IsInitial bool   `json:"is_initial"`
+	IsFinal   bool   `json:"is_final"`
+	Position  int    `json:"position" validate:"min=0"`
+}
+
+type CreateWorkflowTransitionRequest struct {
+	FromState    string `json:"from_state" validate:"required,max=20"`
+	ToState      string `json:"to_state" validate:"required,max=20,nefield=FromState"`
+	RequiredRole string `json:"required_role" validate:"omitempty,max=20"`
+}
+
+type ErrUnknownStatus struct {
+	Status TaskStatus
+}
+
+func (e ErrUnknownStatus) Error() string {
+	return fmt.Sprintf("unknown workflow state: %s", e.Status)
+}
+
+type ErrTransitionNotAllowed struct {
+	From TaskStatus
+	To   TaskStatus
+}
+
+func (e ErrTransitionNotAllowed) Error() string {
+	return fmt.Sprintf("transition from %s to %s is not allowed", e.From, e.To)
+}
+
+type ErrTransitionRoleRequired struct {
+	From TaskStatus
+	To   TaskStatus
+	Role string
+}
+
+func (e ErrTransitionRoleRequired) Error() string {
+	return fmt.Sprintf("transition from %s to %s requires role %s", e.From, e.To, e.Role)
+}
+
+type ErrWorkflowStateInUse struct{}
+
+func (e ErrWorkflowStateInUse) Error() string {
+	return "workflow state is used by tasks or is the initial state"
+}
+
+type ErrWorkflowNotFound struct{}
+
+func (e ErrWorkflowNotFound) Error() string {
+	return "workflow state or transition not found"
+}
+
+type ErrNoInitialState struct{}
+
+func (e ErrNoInitialState) Error() string {
+	return "workflow has no initial state"
+}
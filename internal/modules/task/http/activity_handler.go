@@ -0,0 +1,100 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/service"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type ActivityHandler struct {
+	service  service.ActivityService
+	validate *validator.Validate
+}
+
+func NewActivityHandler(svc service.ActivityService) *ActivityHandler {
+	return &ActivityHandler{
+		service:  svc,
+		validate: validation.Get(),
+	}
+}
+
+func (h *ActivityHandler) ListTaskActivities(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	filter, ok := h.parseFilter(w, r)
+	if !ok {
+		return
+	}
+
+	page, err := h.service.ListTaskActivities(r.Context(), taskID.String(), filter)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	utils.WriteJson(w, page, http.StatusOK, "Task aktiviteleri başarıyla getirildi")
+}
+
+func (h *ActivityHandler) ListUserActivities(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userID := vars["id"]
+
+	if _, err := uuid.Parse(userID); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz kullanıcı ID", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	filter, ok := h.parseFilter(w, r)
+	if !ok {
+		return
+	}
+
+	page, err := h.service.ListUserActivities(r.Context(), userID, filter)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	utils.WriteJson(w, page, http.StatusOK, "Kullanıcı aktiviteleri başarıyla getirildi")
+}
+
+func (h *ActivityHandler) parseFilter(w http.ResponseWriter, r *http.Request) (*domain.ActivityFilter, bool) {
+	filter, err := parseActivityFilter(r.URL.Query())
+	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz sorgu parametresi", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return nil, false
+	}
+
+	if err := h.validate.Struct(filter); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz sorgu parametresi", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return nil, false
+	}
+
+	return filter, true
+}
+
+func (h *ActivityHandler) writeError(w http.ResponseWriter, err error) {
+	switch err.(type) {
+	case domain.ErrTaskNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Task bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrInvalidCursor:
+		resp := utils.ErrorResponse("INVALID_CURSOR", "Geçersiz sayfalama imleci", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	default:
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Aktiviteler getirilemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+	}
+}
@@ -0,0 +1,157 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/service"
+	"github.com/go-playground/validator/v10"
+)
+
+type CommentHandler struct {
+	service  service.CommentService
+	validate *validator.Validate
+}
+
+func NewCommentHandler(svc service.CommentService) *CommentHandler {
+	return &CommentHandler{
+		service:  svc,
+		validate: validation.Get(),
+	}
+}
+
+func (h *CommentHandler) ListComments(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	comments, err := h.service.ListComments(r.Context(), taskID.String())
+	if err != nil {
+		h.writeError(w, err, "Yorumlar getirilemedi")
+		return
+	}
+
+	utils.WriteJson(w, comments, http.StatusOK, "Yorumlar başarıyla getirildi")
+}
+
+func (h *CommentHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req domain.CreateCommentRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	comment, err := h.service.CreateComment(r.Context(), taskID.String(), &req)
+	if err != nil {
+		h.writeError(w, err, "Yorum eklenemedi")
+		return
+	}
+
+	utils.WriteJson(w, comment, http.StatusCreated, "Yorum başarıyla eklendi")
+}
+
+func (h *CommentHandler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+	commentID, ok := parseID(w, r, "commentId")
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateCommentRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	comment, err := h.service.UpdateComment(r.Context(), taskID.String(), commentID.String(), &req)
+	if err != nil {
+		h.writeError(w, err, "Yorum güncellenemedi")
+		return
+	}
+
+	utils.WriteJson(w, comment, http.StatusOK, "Yorum başarıyla güncellendi")
+}
+
+func (h *CommentHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+	commentID, ok := parseID(w, r, "commentId")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteComment(r.Context(), taskID.String(), commentID.String()); err != nil {
+		h.writeError(w, err, "Yorum silinemedi")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Yorum başarıyla silindi")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *CommentHandler) GetCommentHistory(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+	commentID, ok := parseID(w, r, "commentId")
+	if !ok {
+		return
+	}
+
+	edits, err := h.service.GetCommentHistory(r.Context(), taskID.String(), commentID.String())
+	if err != nil {
+		h.writeError(w, err, "Yorum geçmişi getirilemedi")
+		return
+	}
+
+	utils.WriteJson(w, edits, http.StatusOK, "Yorum geçmişi başarıyla getirildi")
+}
+
+func (h *CommentHandler) decode(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	return true
+}
+
+func (h *CommentHandler) writeError(w http.ResponseWriter, err error, message string) {
+	switch err.(type) {
+	case domain.ErrTaskNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Task bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrCommentNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Yorum bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrInvalidParentComment:
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Yanıtlanan yorum bu task'a ait değil", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	case domain.ErrCommentForbidden:
+		resp := utils.ErrorResponse("FORBIDDEN", "Bu yorumu sadece yazarı veya admin değiştirebilir", "")
+		utils.Return(w, http.StatusForbidden, resp)
+	default:
+		resp := utils.ErrorResponse("INTERNAL_ERROR", message, err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+	}
+}
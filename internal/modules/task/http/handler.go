@@ -9,6 +9,7 @@ import (
 	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
 	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/service"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 )
 
@@ -40,6 +41,11 @@ func (h *TaskHandler) CreateTask(w http.ResponseWriter, r *http.Request) {
 
 	task, err := h.service.CreateTask(r.Context(), &req)
 	if err != nil {
+		if _, ok := err.(domain.ErrParentTaskNotFound); ok {
+			resp := utils.ErrorResponse("VALIDATION_ERROR", "Üst görev bulunamadı", err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+			return
+		}
 		resp := utils.ErrorResponse("INTERNAL_ERROR", "Task oluşturulamadı", err.Error())
 		utils.Return(w, http.StatusInternalServerError, resp)
 		return
@@ -49,21 +55,48 @@ func (h *TaskHandler) CreateTask(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TaskHandler) ListTasks(w http.ResponseWriter, r *http.Request) {
-	tasks, err := h.service.ListTasks(r.Context())
+	filter, err := parseTaskFilter(r.URL.Query())
 	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz sorgu parametresi", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.validate.Struct(filter); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz sorgu parametresi", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	page, err := h.service.ListTasks(r.Context(), filter)
+	if err != nil {
+		if _, ok := err.(domain.ErrInvalidCursor); ok {
+			resp := utils.ErrorResponse("INVALID_CURSOR", "Geçersiz sayfalama imleci", err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+			return
+		}
 		resp := utils.ErrorResponse("INTERNAL_ERROR", "Task listesi getirilemedi", err.Error())
 		utils.Return(w, http.StatusInternalServerError, resp)
 		return
 	}
 
-	utils.WriteJson(w, tasks, http.StatusOK, "Task listesi başarıyla getirildi")
+	utils.WriteJson(w, page, http.StatusOK, "Task listesi başarıyla getirildi")
 }
 
 func (h *TaskHandler) GetTask(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	taskID := vars["id"]
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
 
-	task, err := h.service.GetTask(r.Context(), taskID)
+	include, err := parseTaskInclude(r.URL.Query())
+	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz sorgu parametresi", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	task, err := h.service.GetTask(r.Context(), taskID.String(), include)
 	if err != nil {
 		resp := utils.ErrorResponse("INTERNAL_ERROR", "Task getirilemedi", err.Error())
 		utils.Return(w, http.StatusInternalServerError, resp)
@@ -79,9 +112,102 @@ func (h *TaskHandler) GetTask(w http.ResponseWriter, r *http.Request) {
 	utils.WriteJson(w, task, http.StatusOK, "Task başarıyla getirildi")
 }
 
+func (h *TaskHandler) UpdateTask(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	task, err := h.service.UpdateTask(r.Context(), taskID.String(), &req)
+	if err != nil {
+		if _, ok := err.(domain.ErrTaskNotFound); ok {
+			resp := utils.ErrorResponse("NOT_FOUND", "Task bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+			return
+		}
+		if _, ok := err.(domain.ErrScopeDenied); ok {
+			resp := utils.ErrorResponse("SCOPE_FORBIDDEN", "Atama kapsamınız bu işleme izin vermiyor", err.Error())
+			utils.Return(w, http.StatusForbidden, resp)
+			return
+		}
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Task güncellenemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, task, http.StatusOK, "Task başarıyla güncellendi")
+}
+
+func (h *TaskHandler) DeleteTask(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteTask(r.Context(), taskID.String()); err != nil {
+		if _, ok := err.(domain.ErrTaskNotFound); ok {
+			resp := utils.ErrorResponse("NOT_FOUND", "Task bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+			return
+		}
+		if _, ok := err.(domain.ErrScopeDenied); ok {
+			resp := utils.ErrorResponse("SCOPE_FORBIDDEN", "Atama kapsamınız bu işleme izin vermiyor", err.Error())
+			utils.Return(w, http.StatusForbidden, resp)
+			return
+		}
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Task silinemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Task başarıyla silindi")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *TaskHandler) RestoreTask(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	task, err := h.service.RestoreTask(r.Context(), taskID.String())
+	if err != nil {
+		if _, ok := err.(domain.ErrTaskNotFound); ok {
+			resp := utils.ErrorResponse("NOT_FOUND", "Silinmiş task bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+			return
+		}
+		if _, ok := err.(domain.ErrScopeDenied); ok {
+			resp := utils.ErrorResponse("SCOPE_FORBIDDEN", "Atama kapsamınız bu işleme izin vermiyor", err.Error())
+			utils.Return(w, http.StatusForbidden, resp)
+			return
+		}
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Task geri yüklenemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, task, http.StatusOK, "Task başarıyla geri yüklendi")
+}
+
 func (h *TaskHandler) UpdateTaskStatus(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	taskID := vars["id"]
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
 
 	var req domain.UpdateStatusRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -96,9 +222,30 @@ func (h *TaskHandler) UpdateTaskStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.service.UpdateTaskStatus(r.Context(), taskID, &req); err != nil {
-		resp := utils.ErrorResponse("INTERNAL_ERROR", "Task durumu güncellenemedi", err.Error())
-		utils.Return(w, http.StatusInternalServerError, resp)
+	if err := h.service.UpdateTaskStatus(r.Context(), taskID.String(), &req); err != nil {
+		switch err.(type) {
+		case domain.ErrTaskNotFound:
+			resp := utils.ErrorResponse("NOT_FOUND", "Task bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+		case domain.ErrUnknownStatus:
+			resp := utils.ErrorResponse("UNKNOWN_STATUS", "Workflow'da böyle bir durum yok", err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+		case domain.ErrTransitionNotAllowed:
+			resp := utils.ErrorResponse("INVALID_STATUS_TRANSITION", "Bu durum geçişine izin verilmiyor", err.Error())
+			utils.Return(w, http.StatusConflict, resp)
+		case domain.ErrTransitionRoleRequired:
+			resp := utils.ErrorResponse("TRANSITION_ROLE_REQUIRED", "Bu durum geçişi için yetkiniz yok", err.Error())
+			utils.Return(w, http.StatusConflict, resp)
+		case domain.ErrScopeDenied:
+			resp := utils.ErrorResponse("SCOPE_FORBIDDEN", "Atama kapsamınız bu işleme izin vermiyor", err.Error())
+			utils.Return(w, http.StatusForbidden, resp)
+		case domain.ErrTaskBlocked:
+			resp := utils.ErrorResponse("TASK_BLOCKED", "Açık engelleyicisi veya bitmemiş alt görevi olan task tamamlanamaz", err.Error())
+			utils.Return(w, http.StatusConflict, resp)
+		default:
+			resp := utils.ErrorResponse("INTERNAL_ERROR", "Task durumu güncellenemedi", err.Error())
+			utils.Return(w, http.StatusInternalServerError, resp)
+		}
 		return
 	}
 
@@ -107,8 +254,10 @@ func (h *TaskHandler) UpdateTaskStatus(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TaskHandler) AssignTask(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	taskID := vars["id"]
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
 
 	var req domain.AssignTaskRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -125,6 +274,11 @@ func (h *TaskHandler) AssignTask(w http.ResponseWriter, r *http.Request) {
 
 	assignment, err := h.service.AssignTask(r.Context(), taskID, &req)
 	if err != nil {
+		if _, ok := err.(domain.ErrTaskNotFound); ok {
+			resp := utils.ErrorResponse("NOT_FOUND", "Task bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+			return
+		}
 		resp := utils.ErrorResponse("INTERNAL_ERROR", "Task ataması yapılamadı", err.Error())
 		utils.Return(w, http.StatusInternalServerError, resp)
 		return
@@ -134,10 +288,17 @@ func (h *TaskHandler) AssignTask(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TaskHandler) UnassignTask(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	assignmentID := vars["id"]
+	assignmentID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
 
-	if err := h.service.UnassignTask(r.Context(), assignmentID); err != nil {
+	if err := h.service.UnassignTask(r.Context(), assignmentID.String()); err != nil {
+		if _, ok := err.(domain.ErrAssignmentNotFound); ok {
+			resp := utils.ErrorResponse("NOT_FOUND", "Atama bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+			return
+		}
 		resp := utils.ErrorResponse("INTERNAL_ERROR", "Task ataması kaldırılamadı", err.Error())
 		utils.Return(w, http.StatusInternalServerError, resp)
 		return
@@ -148,10 +309,12 @@ func (h *TaskHandler) UnassignTask(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TaskHandler) GetTaskAssignments(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	taskID := vars["id"]
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
 
-	assignments, err := h.service.GetTaskAssignments(r.Context(), taskID)
+	assignments, err := h.service.GetTaskAssignments(r.Context(), taskID.String())
 	if err != nil {
 		resp := utils.ErrorResponse("INTERNAL_ERROR", "Task atamaları getirilemedi", err.Error())
 		utils.Return(w, http.StatusInternalServerError, resp)
@@ -160,3 +323,13 @@ func (h *TaskHandler) GetTaskAssignments(w http.ResponseWriter, r *http.Request)
 
 	utils.WriteJson(w, assignments, http.StatusOK, "Task atamaları başarıyla getirildi")
 }
+
+func parseID(w http.ResponseWriter, r *http.Request, key string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(mux.Vars(r)[key])
+	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz UUID formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return uuid.Nil, false
+	}
+	return id, true
+}
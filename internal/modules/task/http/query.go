@@ -0,0 +1,157 @@
+package http
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/google/uuid"
+)
+
+// parseTaskFilter GET /api/tasks query string'ini domain.TaskFilter'a çevirir.
+// Enum ve limit kontrolleri validator'a bırakılır.
+func parseTaskFilter(q url.Values) (*domain.TaskFilter, error) {
+	filter := &domain.TaskFilter{
+		Title:  strings.TrimSpace(q.Get("q")),
+		Sort:   domain.TaskSortField(q.Get("sort")),
+		Order:  domain.SortOrder(strings.ToLower(q.Get("order"))),
+		Cursor: q.Get("cursor"),
+	}
+
+	for _, raw := range q["status"] {
+		for _, status := range strings.Split(raw, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				filter.Statuses = append(filter.Statuses, domain.TaskStatus(status))
+			}
+		}
+	}
+
+	for _, raw := range q["tag"] {
+		for _, tag := range strings.Split(raw, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				filter.Tags = append(filter.Tags, tag)
+			}
+		}
+	}
+	filter.TagMode = domain.TagMatchMode(strings.ToLower(q.Get("tag_mode")))
+
+	var err error
+	if filter.CreatedBy, err = parseUUIDParam(q, "created_by"); err != nil {
+		return nil, err
+	}
+	if filter.Assignee, err = parseUUIDParam(q, "assignee"); err != nil {
+		return nil, err
+	}
+	if filter.Team, err = parseUUIDParam(q, "team"); err != nil {
+		return nil, err
+	}
+	if filter.CreatedFrom, err = parseTimeParam(q, "created_from", false); err != nil {
+		return nil, err
+	}
+	if filter.CreatedTo, err = parseTimeParam(q, "created_to", true); err != nil {
+		return nil, err
+	}
+	if filter.UpdatedFrom, err = parseTimeParam(q, "updated_from", false); err != nil {
+		return nil, err
+	}
+	if filter.UpdatedTo, err = parseTimeParam(q, "updated_to", true); err != nil {
+		return nil, err
+	}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("limit sayı olmalıdır")
+		}
+		filter.Limit = limit
+	}
+
+	if v := q.Get("include_total"); v != "" {
+		includeTotal, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("include_total true veya false olmalıdır")
+		}
+		filter.IncludeTotal = includeTotal
+	}
+
+	return filter, nil
+}
+
+func parseUUIDParam(q url.Values, key string) (*uuid.UUID, error) {
+	v := q.Get(key)
+	if v == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s geçerli bir UUID olmalıdır", key)
+	}
+	return &id, nil
+}
+
+// parseTimeParam RFC3339 ya da YYYY-MM-DD kabul eder. Sadece tarih verilen
+// bitiş parametreleri günün sonuna kadar kapsayacak şekilde genişletilir.
+func parseTimeParam(q url.Values, key string, endOfDay bool) (*time.Time, error) {
+	v := q.Get(key)
+	if v == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(time.DateOnly, v)
+	if err != nil {
+		return nil, fmt.Errorf("%s RFC3339 veya YYYY-MM-DD formatında olmalıdır", key)
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+	return &t, nil
+}
+
+// parseActivityFilter aktivite timeline endpoint'lerinin query string'ini domain.ActivityFilter'a çevirir.
+func parseActivityFilter(q url.Values) (*domain.ActivityFilter, error) {
+	filter := &domain.ActivityFilter{
+		Cursor: q.Get("cursor"),
+	}
+
+	for _, raw := range q["action"] {
+		for _, action := range strings.Split(raw, ",") {
+			if action = strings.TrimSpace(action); action != "" {
+				filter.Actions = append(filter.Actions, domain.ActivityAction(action))
+			}
+		}
+	}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("limit sayı olmalıdır")
+		}
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
+
+// parseTaskInclude GET /api/tasks/{id} için include=subtasks,dependencies parametresini çözer.
+func parseTaskInclude(q url.Values) (domain.TaskInclude, error) {
+	include := domain.TaskInclude{}
+	for _, raw := range q["include"] {
+		for _, part := range strings.Split(raw, ",") {
+			switch strings.TrimSpace(part) {
+			case "":
+			case "subtasks":
+				include.Subtasks = true
+			case "dependencies":
+				include.Dependencies = true
+			default:
+				return include, fmt.Errorf("include sadece subtasks ve dependencies değerlerini alabilir")
+			}
+		}
+	}
+	return include, nil
+}
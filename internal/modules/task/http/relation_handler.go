@@ -0,0 +1,134 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/service"
+	"github.com/go-playground/validator/v10"
+)
+
+type RelationHandler struct {
+	service  service.RelationService
+	validate *validator.Validate
+}
+
+func NewRelationHandler(svc service.RelationService) *RelationHandler {
+	return &RelationHandler{
+		service:  svc,
+		validate: validation.Get(),
+	}
+}
+
+func (h *RelationHandler) SetParent(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req domain.SetParentRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	task, err := h.service.SetParent(r.Context(), taskID.String(), &req)
+	if err != nil {
+		h.writeError(w, err, "Üst görev güncellenemedi")
+		return
+	}
+
+	utils.WriteJson(w, task, http.StatusOK, "Üst görev başarıyla güncellendi")
+}
+
+func (h *RelationHandler) GetDependencies(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	dependencies, err := h.service.GetDependencies(r.Context(), taskID.String())
+	if err != nil {
+		h.writeError(w, err, "Task bağımlılıkları getirilemedi")
+		return
+	}
+
+	utils.WriteJson(w, dependencies, http.StatusOK, "Task bağımlılıkları başarıyla getirildi")
+}
+
+func (h *RelationHandler) AddDependency(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req domain.AddDependencyRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := h.service.AddDependency(r.Context(), taskID.String(), &req); err != nil {
+		h.writeError(w, err, "Bağımlılık eklenemedi")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Bağımlılık başarıyla eklendi")
+	utils.Return(w, http.StatusCreated, resp)
+}
+
+func (h *RelationHandler) RemoveDependency(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+	blockerID, ok := parseID(w, r, "blockerId")
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveDependency(r.Context(), taskID.String(), blockerID.String()); err != nil {
+		h.writeError(w, err, "Bağımlılık kaldırılamadı")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Bağımlılık başarıyla kaldırıldı")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *RelationHandler) decode(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	return true
+}
+
+func (h *RelationHandler) writeError(w http.ResponseWriter, err error, message string) {
+	switch err.(type) {
+	case domain.ErrTaskNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Task bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrDependencyNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Bağımlılık bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrParentTaskNotFound:
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Üst görev bulunamadı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	case domain.ErrTaskCycle:
+		resp := utils.ErrorResponse("TASK_CYCLE", "Bu bağlantı döngü oluşturur", err.Error())
+		utils.Return(w, http.StatusConflict, resp)
+	default:
+		resp := utils.ErrorResponse("INTERNAL_ERROR", message, err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+	}
+}
@@ -0,0 +1,175 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/service"
+	"github.com/go-playground/validator/v10"
+)
+
+type ScopeHandler struct {
+	service  service.ScopeService
+	validate *validator.Validate
+}
+
+func NewScopeHandler(svc service.ScopeService) *ScopeHandler {
+	return &ScopeHandler{
+		service:  svc,
+		validate: validation.Get(),
+	}
+}
+
+func (h *ScopeHandler) ListScopes(w http.ResponseWriter, r *http.Request) {
+	scopes, err := h.service.ListScopes(r.Context())
+	if err != nil {
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Scope listesi getirilemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, scopes, http.StatusOK, "Scope listesi başarıyla getirildi")
+}
+
+func (h *ScopeHandler) CreateScope(w http.ResponseWriter, r *http.Request) {
+	var req domain.CreateScopeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	scope, err := h.service.CreateScope(r.Context(), &req)
+	if err != nil {
+		resp := utils.ErrorResponse("DATABASE_ERROR", "Scope oluşturulamadı (isim kullanımda olabilir)", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, scope, http.StatusCreated, "Scope başarıyla oluşturuldu")
+}
+
+func (h *ScopeHandler) UpdateScope(w http.ResponseWriter, r *http.Request) {
+	scopeID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateScopeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	scope, err := h.service.UpdateScope(r.Context(), scopeID.String(), &req)
+	if err != nil {
+		if _, ok := err.(domain.ErrScopeNotFound); ok {
+			resp := utils.ErrorResponse("NOT_FOUND", "Scope bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+			return
+		}
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Scope güncellenemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, scope, http.StatusOK, "Scope başarıyla güncellendi")
+}
+
+func (h *ScopeHandler) DeleteScope(w http.ResponseWriter, r *http.Request) {
+	scopeID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteScope(r.Context(), scopeID.String()); err != nil {
+		if _, ok := err.(domain.ErrScopeNotFound); ok {
+			resp := utils.ErrorResponse("NOT_FOUND", "Scope bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+			return
+		}
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Scope silinemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Scope başarıyla silindi")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *ScopeHandler) AddScopeToAssignment(w http.ResponseWriter, r *http.Request) {
+	assignmentID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req domain.AddAssignmentScopeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.service.AddScopeToAssignment(r.Context(), assignmentID.String(), &req); err != nil {
+		h.writeAssignmentScopeError(w, err, "Scope atamaya eklenemedi")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Scope atamaya başarıyla eklendi")
+	utils.Return(w, http.StatusCreated, resp)
+}
+
+func (h *ScopeHandler) RemoveScopeFromAssignment(w http.ResponseWriter, r *http.Request) {
+	assignmentID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+	scopeID, ok := parseID(w, r, "scopeId")
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveScopeFromAssignment(r.Context(), assignmentID.String(), scopeID.String()); err != nil {
+		h.writeAssignmentScopeError(w, err, "Scope atamadan kaldırılamadı")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Scope atamadan başarıyla kaldırıldı")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *ScopeHandler) writeAssignmentScopeError(w http.ResponseWriter, err error, message string) {
+	switch err.(type) {
+	case domain.ErrAssignmentNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Atama bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrScopeNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Scope bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	default:
+		resp := utils.ErrorResponse("INTERNAL_ERROR", message, err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+	}
+}
@@ -0,0 +1,194 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/service"
+	"github.com/go-playground/validator/v10"
+)
+
+type TagHandler struct {
+	service  service.TagService
+	validate *validator.Validate
+}
+
+func NewTagHandler(svc service.TagService) *TagHandler {
+	return &TagHandler{
+		service:  svc,
+		validate: validation.Get(),
+	}
+}
+
+func (h *TagHandler) ListTags(w http.ResponseWriter, r *http.Request) {
+	tags, err := h.service.ListTags(r.Context())
+	if err != nil {
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Tag listesi getirilemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, tags, http.StatusOK, "Tag listesi başarıyla getirildi")
+}
+
+func (h *TagHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
+	var req domain.CreateTagRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	tag, err := h.service.CreateTag(r.Context(), &req)
+	if err != nil {
+		resp := utils.ErrorResponse("DATABASE_ERROR", "Tag oluşturulamadı (isim kullanımda olabilir)", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, tag, http.StatusCreated, "Tag başarıyla oluşturuldu")
+}
+
+func (h *TagHandler) RenameTag(w http.ResponseWriter, r *http.Request) {
+	tagID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req domain.RenameTagRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	tag, err := h.service.RenameTag(r.Context(), tagID.String(), &req)
+	if err != nil {
+		h.writeError(w, err, "Tag yeniden adlandırılamadı")
+		return
+	}
+
+	utils.WriteJson(w, tag, http.StatusOK, "Tag başarıyla yeniden adlandırıldı")
+}
+
+func (h *TagHandler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	tagID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteTag(r.Context(), tagID.String()); err != nil {
+		h.writeError(w, err, "Tag silinemedi")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Tag başarıyla silindi")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *TagHandler) MergeTag(w http.ResponseWriter, r *http.Request) {
+	sourceID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req domain.MergeTagRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	tag, err := h.service.MergeTag(r.Context(), sourceID.String(), &req)
+	if err != nil {
+		h.writeError(w, err, "Tag'ler birleştirilemedi")
+		return
+	}
+
+	utils.WriteJson(w, tag, http.StatusOK, "Tag'ler başarıyla birleştirildi")
+}
+
+func (h *TagHandler) GetTaskTags(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	tags, err := h.service.GetTaskTags(r.Context(), taskID.String())
+	if err != nil {
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Task tag'leri getirilemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, tags, http.StatusOK, "Task tag'leri başarıyla getirildi")
+}
+
+func (h *TagHandler) AddTagToTask(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req domain.AddTaskTagRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := h.service.AddTagToTask(r.Context(), taskID.String(), &req); err != nil {
+		h.writeError(w, err, "Tag task'a eklenemedi")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Tag task'a başarıyla eklendi")
+	utils.Return(w, http.StatusCreated, resp)
+}
+
+func (h *TagHandler) RemoveTagFromTask(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+	tagID, ok := parseID(w, r, "tagId")
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveTagFromTask(r.Context(), taskID.String(), tagID.String()); err != nil {
+		h.writeError(w, err, "Tag task'tan kaldırılamadı")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Tag task'tan başarıyla kaldırıldı")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *TagHandler) decode(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	return true
+}
+
+func (h *TagHandler) writeError(w http.ResponseWriter, err error, message string) {
+	switch err.(type) {
+	case domain.ErrTagNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Tag bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrTaskNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Task bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrTagMergeSelf:
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Tag kendisiyle birleştirilemez", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	default:
+		resp := utils.ErrorResponse("INTERNAL_ERROR", message, err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+	}
+}
@@ -0,0 +1,140 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/service"
+	"github.com/go-playground/validator/v10"
+)
+
+type TeamAssignmentHandler struct {
+	service  service.TeamAssignmentService
+	validate *validator.Validate
+}
+
+func NewTeamAssignmentHandler(svc service.TeamAssignmentService) *TeamAssignmentHandler {
+	return &TeamAssignmentHandler{
+		service:  svc,
+		validate: validation.Get(),
+	}
+}
+
+func (h *TeamAssignmentHandler) GetTaskTeams(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	assignments, err := h.service.GetTaskTeams(r.Context(), taskID.String())
+	if err != nil {
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Takım atamaları getirilemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, assignments, http.StatusOK, "Takım atamaları başarıyla getirildi")
+}
+
+func (h *TeamAssignmentHandler) AssignTeam(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req domain.AssignTeamRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	assignment, err := h.service.AssignTeam(r.Context(), taskID.String(), &req)
+	if err != nil {
+		h.writeError(w, err, "Task takıma atanamadı")
+		return
+	}
+
+	utils.WriteJson(w, assignment, http.StatusCreated, "Task takıma başarıyla atandı")
+}
+
+func (h *TeamAssignmentHandler) UnassignTeam(w http.ResponseWriter, r *http.Request) {
+	assignmentID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.UnassignTeam(r.Context(), assignmentID.String()); err != nil {
+		h.writeError(w, err, "Takım ataması kaldırılamadı")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Takım ataması başarıyla kaldırıldı")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *TeamAssignmentHandler) DistributeTask(w http.ResponseWriter, r *http.Request) {
+	taskID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req domain.DistributeTaskRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	assignment, err := h.service.DistributeTask(r.Context(), taskID.String(), &req)
+	if err != nil {
+		h.writeError(w, err, "Task takım üyesine atanamadı")
+		return
+	}
+
+	utils.WriteJson(w, assignment, http.StatusCreated, "Task takım üyesine başarıyla atandı")
+}
+
+func (h *TeamAssignmentHandler) decode(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	return true
+}
+
+func (h *TeamAssignmentHandler) writeError(w http.ResponseWriter, err error, message string) {
+	switch err.(type) {
+	case domain.ErrTaskNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Task bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrTeamNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Takım bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrTeamAssignmentNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Takım ataması bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrTeamAlreadyAssigned:
+		resp := utils.ErrorResponse("CONFLICT", "Task bu takıma zaten atanmış", err.Error())
+		utils.Return(w, http.StatusConflict, resp)
+	case domain.ErrAlreadyAssigned:
+		resp := utils.ErrorResponse("CONFLICT", "Kullanıcı bu task'a zaten atanmış", err.Error())
+		utils.Return(w, http.StatusConflict, resp)
+	case domain.ErrNotTeamLead:
+		resp := utils.ErrorResponse("FORBIDDEN", "Takım task'larını sadece takım lideri dağıtabilir", err.Error())
+		utils.Return(w, http.StatusForbidden, resp)
+	case domain.ErrNotTeamMember:
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Kullanıcı takımın aktif bir üyesi değil", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	default:
+		resp := utils.ErrorResponse("INTERNAL_ERROR", message, err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+	}
+}
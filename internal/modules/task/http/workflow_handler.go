@@ -0,0 +1,133 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/service"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+type WorkflowHandler struct {
+	service  service.WorkflowService
+	validate *validator.Validate
+}
+
+func NewWorkflowHandler(svc service.WorkflowService) *WorkflowHandler {
+	return &WorkflowHandler{
+		service:  svc,
+		validate: validation.Get(),
+	}
+}
+
+func (h *WorkflowHandler) GetWorkflow(w http.ResponseWriter, r *http.Request) {
+	workflow, err := h.service.GetWorkflow(r.Context())
+	if err != nil {
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Workflow getirilemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, workflow, http.StatusOK, "Workflow başarıyla getirildi")
+}
+
+func (h *WorkflowHandler) CreateState(w http.ResponseWriter, r *http.Request) {
+	var req domain.CreateWorkflowStateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	state, err := h.service.CreateState(r.Context(), &req)
+	if err != nil {
+		resp := utils.ErrorResponse("DATABASE_ERROR", "Durum oluşturulamadı (anahtar kullanımda olabilir)", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, state, http.StatusCreated, "Durum başarıyla oluşturuldu")
+}
+
+func (h *WorkflowHandler) DeleteState(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	key := vars["key"]
+
+	if err := h.service.DeleteState(r.Context(), key); err != nil {
+		switch err.(type) {
+		case domain.ErrWorkflowNotFound:
+			resp := utils.ErrorResponse("NOT_FOUND", "Durum bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+		case domain.ErrWorkflowStateInUse:
+			resp := utils.ErrorResponse("STATE_IN_USE", "Durum kullanımda olduğu için silinemez", err.Error())
+			utils.Return(w, http.StatusConflict, resp)
+		default:
+			resp := utils.ErrorResponse("INTERNAL_ERROR", "Durum silinemedi", err.Error())
+			utils.Return(w, http.StatusInternalServerError, resp)
+		}
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Durum başarıyla silindi")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *WorkflowHandler) CreateTransition(w http.ResponseWriter, r *http.Request) {
+	var req domain.CreateWorkflowTransitionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	transition, err := h.service.CreateTransition(r.Context(), &req)
+	if err != nil {
+		if _, ok := err.(domain.ErrUnknownStatus); ok {
+			resp := utils.ErrorResponse("UNKNOWN_STATUS", "Workflow'da böyle bir durum yok", err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+			return
+		}
+		resp := utils.ErrorResponse("DATABASE_ERROR", "Geçiş oluşturulamadı (zaten tanımlı olabilir)", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, transition, http.StatusCreated, "Geçiş başarıyla oluşturuldu")
+}
+
+func (h *WorkflowHandler) DeleteTransition(w http.ResponseWriter, r *http.Request) {
+	transitionID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteTransition(r.Context(), transitionID.String()); err != nil {
+		if _, ok := err.(domain.ErrWorkflowNotFound); ok {
+			resp := utils.ErrorResponse("NOT_FOUND", "Geçiş bulunamadı", "")
+			utils.Return(w, http.StatusNotFound, resp)
+			return
+		}
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Geçiş silinemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Geçiş başarıyla silindi")
+	utils.Return(w, http.StatusOK, resp)
+}
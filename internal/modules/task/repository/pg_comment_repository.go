@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type PostgresCommentRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresCommentRepository(db *sqlx.DB) domain.CommentRepository {
+	return &PostgresCommentRepository{db: db}
+}
+
+const commentColumns = `id, task_id, parent_id, author_id, body, mentions, edited_at, deleted_at, created_at, updated_at`
+
+func (r *PostgresCommentRepository) Create(ctx context.Context, tx *sqlx.Tx, comment *domain.Comment) error {
+	query := `
+		INSERT INTO task_comments (id, task_id, parent_id, author_id, body, mentions, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query,
+		comment.ID, comment.TaskID, comment.ParentID, comment.AuthorID, comment.Body, comment.Mentions,
+		comment.CreatedAt, comment.UpdatedAt)
+	return err
+}
+
+func (r *PostgresCommentRepository) GetByID(ctx context.Context, commentID string) (*domain.Comment, error) {
+	comment := &domain.Comment{}
+	query := `SELECT ` + commentColumns + ` FROM task_comments WHERE id = $1 AND deleted_at IS NULL`
+	err := r.db.GetContext(ctx, comment, query, commentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return comment, nil
+}
+
+// GetByTask silinmiş yorumlar dahil tüm yorumları oluşturulma sırasıyla döner; silinmiş
+// yorumlar thread yapısını korumak için listede kalır.
+func (r *PostgresCommentRepository) GetByTask(ctx context.Context, taskID string) ([]domain.Comment, error) {
+	comments := []domain.Comment{}
+	query := `SELECT ` + commentColumns + ` FROM task_comments WHERE task_id = $1 ORDER BY created_at ASC, id ASC`
+	err := r.db.SelectContext(ctx, &comments, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+func (r *PostgresCommentRepository) Update(ctx context.Context, tx *sqlx.Tx, comment *domain.Comment) error {
+	query := `
+		UPDATE task_comments
+		SET body = $1, mentions = $2, edited_at = $3, updated_at = $4
+		WHERE id = $5 AND deleted_at IS NULL
+	`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	res, err := executor.ExecContext(ctx, query,
+		comment.Body, comment.Mentions, comment.EditedAt, comment.UpdatedAt, comment.ID)
+	if err != nil {
+		return err
+	}
+	return requireAffectedAs(res, domain.ErrCommentNotFound{})
+}
+
+func (r *PostgresCommentRepository) SoftDelete(ctx context.Context, tx *sqlx.Tx, commentID string) error {
+	query := `UPDATE task_comments SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	res, err := executor.ExecContext(ctx, query, time.Now(), commentID)
+	if err != nil {
+		return err
+	}
+	return requireAffectedAs(res, domain.ErrCommentNotFound{})
+}
+
+func (r *PostgresCommentRepository) CreateEdit(ctx context.Context, tx *sqlx.Tx, edit *domain.CommentEdit) error {
+	query := `
+		INSERT INTO task_comment_edits (id, comment_id, body, edited_by, edited_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query, edit.ID, edit.CommentID, edit.Body, edit.EditedBy, edit.EditedAt)
+	return err
+}
+
+func (r *PostgresCommentRepository) GetEdits(ctx context.Context, commentID string) ([]domain.CommentEdit, error) {
+	edits := []domain.CommentEdit{}
+	query := `SELECT id, comment_id, body, edited_by, edited_at FROM task_comment_edits WHERE comment_id = $1 ORDER BY edited_at DESC`
+	err := r.db.SelectContext(ctx, &edits, query, commentID)
+	if err != nil {
+		return nil, err
+	}
+	return edits, nil
+}
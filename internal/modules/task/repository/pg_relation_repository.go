@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type PostgresTaskRelationRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresTaskRelationRepository(db *sqlx.DB) domain.TaskRelationRepository {
+	return &PostgresTaskRelationRepository{db: db}
+}
+
+// openTaskCondition workflow'da final olmayan (veya workflow'da tanımsız) durumdaki task'ları seçer.
+const openTaskCondition = `COALESCE(NOT ws.is_final, TRUE)`
+
+func (r *PostgresTaskRelationRepository) LockGraph(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('task_relations'))`)
+	return err
+}
+
+func (r *PostgresTaskRelationRepository) SetParent(ctx context.Context, tx *sqlx.Tx, taskID string, parentID *string) error {
+	query := `UPDATE tasks SET parent_id = $1, updated_at = NOW() WHERE id = $2 AND deleted_at IS NULL`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	res, err := executor.ExecContext(ctx, query, parentID, taskID)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+func (r *PostgresTaskRelationRepository) IsDescendant(ctx context.Context, tx *sqlx.Tx, ancestorID string, candidateID string) (bool, error) {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT $1::uuid AS id
+			UNION
+			SELECT t.id FROM tasks t INNER JOIN subtree s ON t.parent_id = s.id
+		)
+		SELECT EXISTS (SELECT 1 FROM subtree WHERE id = $2::uuid)
+	`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	var found bool
+	err := sqlx.GetContext(ctx, executor, &found, query, ancestorID, candidateID)
+	return found, err
+}
+
+func (r *PostgresTaskRelationRepository) GetDescendants(ctx context.Context, rootID string) ([]domain.Task, error) {
+	tasks := []domain.Task{}
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM tasks WHERE parent_id = $1 AND deleted_at IS NULL
+			UNION
+			SELECT t.id FROM tasks t INNER JOIN subtree s ON t.parent_id = s.id WHERE t.deleted_at IS NULL
+		)
+		SELECT ` + taskColumns + ` FROM tasks WHERE id IN (SELECT id FROM subtree)
+		ORDER BY created_at ASC, id ASC
+	`
+	err := r.db.SelectContext(ctx, &tasks, query, rootID)
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+func (r *PostgresTaskRelationRepository) CountOpenSubtasks(ctx context.Context, taskID string) (int, error) {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM tasks WHERE parent_id = $1 AND deleted_at IS NULL
+			UNION
+			SELECT t.id FROM tasks t INNER JOIN subtree s ON t.parent_id = s.id WHERE t.deleted_at IS NULL
+		)
+		SELECT COUNT(*)
+		FROM tasks t
+		LEFT JOIN workflow_states ws ON ws.key = t.status
+		WHERE t.id IN (SELECT id FROM subtree) AND ` + openTaskCondition
+
+	var count int
+	err := r.db.GetContext(ctx, &count, query, taskID)
+	return count, err
+}
+
+func (r *PostgresTaskRelationRepository) CreateDependency(ctx context.Context, tx *sqlx.Tx, dependency *domain.TaskDependency) error {
+	query := `
+		INSERT INTO task_dependencies (blocker_id, blocked_id, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query, dependency.BlockerID, dependency.BlockedID, dependency.CreatedAt)
+	return err
+}
+
+func (r *PostgresTaskRelationRepository) DeleteDependency(ctx context.Context, tx *sqlx.Tx, blockerID string, blockedID string) error {
+	query := `DELETE FROM task_dependencies WHERE blocker_id = $1 AND blocked_id = $2`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	res, err := executor.ExecContext(ctx, query, blockerID, blockedID)
+	if err != nil {
+		return err
+	}
+	return requireAffectedAs(res, domain.ErrDependencyNotFound{})
+}
+
+func (r *PostgresTaskRelationRepository) HasPath(ctx context.Context, tx *sqlx.Tx, fromID string, toID string) (bool, error) {
+	query := `
+		WITH RECURSIVE reachable AS (
+			SELECT $1::uuid AS id
+			UNION
+			SELECT d.blocked_id FROM task_dependencies d INNER JOIN reachable r ON d.blocker_id = r.id
+		)
+		SELECT EXISTS (SELECT 1 FROM reachable WHERE id = $2::uuid)
+	`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	var found bool
+	err := sqlx.GetContext(ctx, executor, &found, query, fromID, toID)
+	return found, err
+}
+
+func (r *PostgresTaskRelationRepository) GetBlockers(ctx context.Context, taskID string) ([]domain.TaskRef, error) {
+	return r.refs(ctx, `d.blocker_id`, `d.blocked_id`, taskID)
+}
+
+func (r *PostgresTaskRelationRepository) GetBlocking(ctx context.Context, taskID string) ([]domain.TaskRef, error) {
+	return r.refs(ctx, `d.blocked_id`, `d.blocker_id`, taskID)
+}
+
+func (r *PostgresTaskRelationRepository) refs(ctx context.Context, joinColumn, filterColumn, taskID string) ([]domain.TaskRef, error) {
+	refs := []domain.TaskRef{}
+	query := `
+		SELECT t.id, t.title, t.status, ` + openTaskCondition + ` AS is_open
+		FROM task_dependencies d
+		INNER JOIN tasks t ON t.id = ` + joinColumn + `
+		LEFT JOIN workflow_states ws ON ws.key = t.status
+		WHERE ` + filterColumn + ` = $1 AND t.deleted_at IS NULL
+		ORDER BY d.created_at ASC
+	`
+	err := r.db.SelectContext(ctx, &refs, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+func (r *PostgresTaskRelationRepository) CountOpenBlockers(ctx context.Context, taskID string) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM task_dependencies d
+		INNER JOIN tasks t ON t.id = d.blocker_id
+		LEFT JOIN workflow_states ws ON ws.key = t.status
+		WHERE d.blocked_id = $1 AND t.deleted_at IS NULL AND ` + openTaskCondition
+
+	var count int
+	err := r.db.GetContext(ctx, &count, query, taskID)
+	return count, err
+}
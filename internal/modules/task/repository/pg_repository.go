@@ -3,10 +3,14 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type PostgresTaskRepository struct {
@@ -17,23 +21,28 @@ func NewPostgresTaskRepository(db *sqlx.DB) domain.TaskRepository {
 	return &PostgresTaskRepository{db: db}
 }
 
-func (r *PostgresTaskRepository) BeginTx(ctx context.Context) (*sqlx.Tx, error) {
-	return r.db.BeginTxx(ctx, nil)
-}
+const taskColumns = `id, title, description, status, priority, due_date, estimated_effort, created_by, parent_id, created_at, updated_at, deleted_at`
 
-func (r *PostgresTaskRepository) Create(ctx context.Context, task *domain.Task) error {
+func (r *PostgresTaskRepository) Create(ctx context.Context, tx *sqlx.Tx, task *domain.Task) error {
 	query := `
-		INSERT INTO tasks (id, title, status, created_by, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO tasks (id, title, description, status, priority, due_date, estimated_effort, created_by, parent_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`
-	_, err := r.db.ExecContext(ctx, query,
-		task.ID, task.Title, task.Status, task.CreatedBy, task.CreatedAt, task.UpdatedAt)
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query,
+		task.ID, task.Title, task.Description, task.Status, task.Priority, task.DueDate, task.EstimatedEffort,
+		task.CreatedBy, task.ParentID, task.CreatedAt, task.UpdatedAt)
 	return err
 }
 
 func (r *PostgresTaskRepository) GetByID(ctx context.Context, taskID string) (*domain.Task, error) {
 	task := &domain.Task{}
-	query := `SELECT id, title, status, created_by, created_at, updated_at FROM tasks WHERE id = $1`
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE id = $1 AND deleted_at IS NULL`
 	err := r.db.GetContext(ctx, task, query, taskID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -44,22 +53,226 @@ func (r *PostgresTaskRepository) GetByID(ctx context.Context, taskID string) (*d
 	return task, nil
 }
 
-func (r *PostgresTaskRepository) UpdateStatus(ctx context.Context, taskID string, status domain.TaskStatus) error {
-	query := `UPDATE tasks SET status = $1, updated_at = $2 WHERE id = $3`
-	_, err := r.db.ExecContext(ctx, query, status, time.Now(), taskID)
-	return err
+func (r *PostgresTaskRepository) GetDeletedByID(ctx context.Context, taskID string) (*domain.Task, error) {
+	task := &domain.Task{}
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE id = $1 AND deleted_at IS NOT NULL`
+	err := r.db.GetContext(ctx, task, query, taskID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return task, nil
+}
+
+func (r *PostgresTaskRepository) Update(ctx context.Context, tx *sqlx.Tx, task *domain.Task) error {
+	query := `
+		UPDATE tasks
+		SET title = $1, description = $2, priority = $3, due_date = $4, estimated_effort = $5, updated_at = $6
+		WHERE id = $7 AND deleted_at IS NULL
+	`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	res, err := executor.ExecContext(ctx, query,
+		task.Title, task.Description, task.Priority, task.DueDate, task.EstimatedEffort, task.UpdatedAt, task.ID)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+// UpdateStatus görevin durumu hâlâ from ise to'ya çevirir. Geçiş servis tarafında from'a
+// göre doğrulandığından, arada durumu değiştiren başka bir istek varsa güncelleme yapılmaz.
+func (r *PostgresTaskRepository) UpdateStatus(ctx context.Context, tx *sqlx.Tx, taskID string, from, to domain.TaskStatus) error {
+	query := `UPDATE tasks SET status = $1, updated_at = $2 WHERE id = $3 AND status = $4 AND deleted_at IS NULL`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	res, err := executor.ExecContext(ctx, query, to, time.Now(), taskID, from)
+	if err != nil {
+		return err
+	}
+	return requireAffectedAs(res, domain.ErrTransitionNotAllowed{From: from, To: to})
 }
 
-func (r *PostgresTaskRepository) List(ctx context.Context) ([]domain.Task, error) {
+func (r *PostgresTaskRepository) SoftDelete(ctx context.Context, tx *sqlx.Tx, taskID string) error {
+	query := `UPDATE tasks SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	res, err := executor.ExecContext(ctx, query, time.Now(), taskID)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+func (r *PostgresTaskRepository) Restore(ctx context.Context, tx *sqlx.Tx, taskID string) error {
+	query := `UPDATE tasks SET deleted_at = NULL, updated_at = $1 WHERE id = $2 AND deleted_at IS NOT NULL`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	res, err := executor.ExecContext(ctx, query, time.Now(), taskID)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+func requireAffected(res sql.Result) error {
+	return requireAffectedAs(res, domain.ErrTaskNotFound{})
+}
+
+// requireAffectedAs hiçbir satır etkilenmediyse verilen not-found hatasını döner.
+func requireAffectedAs(res sql.Result, notFound error) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return notFound
+	}
+	return nil
+}
+
+func (r *PostgresTaskRepository) List(ctx context.Context, filter *domain.TaskFilter) ([]domain.Task, error) {
+	where, args := buildTaskWhere(filter)
+
+	sortColumn := taskSortColumns[filter.Sort]
+	direction := "DESC"
+	comparator := "<"
+	if filter.Order == domain.SortAsc {
+		direction = "ASC"
+		comparator = ">"
+	}
+
+	if filter.After != nil {
+		args = append(args, filter.After.Value, filter.After.ID)
+		where = append(where, fmt.Sprintf("(%s, t.id) %s ($%d::%s, $%d::uuid)",
+			sortColumn, comparator, len(args)-1, taskSortCasts[filter.Sort], len(args)))
+	}
+
+	query := `SELECT ` + taskColumns + ` FROM tasks t WHERE ` + strings.Join(where, " AND ")
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf(" ORDER BY %s %s, t.id %s LIMIT $%d", sortColumn, direction, direction, len(args))
+
 	tasks := []domain.Task{}
-	query := `SELECT id, title, status, created_by, created_at, updated_at FROM tasks ORDER BY created_at DESC`
-	err := r.db.SelectContext(ctx, &tasks, query)
+	err := r.db.SelectContext(ctx, &tasks, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	return tasks, nil
 }
 
+func (r *PostgresTaskRepository) Count(ctx context.Context, filter *domain.TaskFilter) (int, error) {
+	where, args := buildTaskWhere(filter)
+
+	query := `SELECT COUNT(*) FROM tasks t WHERE ` + strings.Join(where, " AND ")
+
+	var total int
+	err := r.db.GetContext(ctx, &total, query, args...)
+	return total, err
+}
+
+var taskSortColumns = map[domain.TaskSortField]string{
+	domain.TaskSortCreatedAt: "t.created_at",
+	domain.TaskSortUpdatedAt: "t.updated_at",
+	domain.TaskSortTitle:     "t.title",
+	domain.TaskSortStatus:    "t.status",
+}
+
+var taskSortCasts = map[domain.TaskSortField]string{
+	domain.TaskSortCreatedAt: "timestamptz",
+	domain.TaskSortUpdatedAt: "timestamptz",
+	domain.TaskSortTitle:     "text",
+	domain.TaskSortStatus:    "text",
+}
+
+// buildTaskWhere filtre alanlarını cursor hariç WHERE koşullarına çevirir.
+func buildTaskWhere(filter *domain.TaskFilter) ([]string, []interface{}) {
+	where := []string{"t.deleted_at IS NULL"}
+	args := []interface{}{}
+
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = string(status)
+		}
+		add("t.status = ANY($%d)", pq.Array(statuses))
+	}
+	if filter.CreatedBy != nil {
+		add("t.created_by = $%d", *filter.CreatedBy)
+	}
+	if filter.Assignee != nil {
+		add("EXISTS (SELECT 1 FROM task_assignments ta WHERE ta.task_id = t.id AND ta.user_id = $%d)", *filter.Assignee)
+	}
+	if filter.Team != nil {
+		add(`(EXISTS (SELECT 1 FROM task_team_assignments tta WHERE tta.task_id = t.id AND tta.team_id = $%[1]d)
+			OR EXISTS (SELECT 1 FROM task_assignments ta WHERE ta.task_id = t.id AND ta.team_id = $%[1]d))`, *filter.Team)
+	}
+	if filter.CreatedFrom != nil {
+		add("t.created_at >= $%d", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		add("t.created_at <= $%d", *filter.CreatedTo)
+	}
+	if filter.UpdatedFrom != nil {
+		add("t.updated_at >= $%d", *filter.UpdatedFrom)
+	}
+	if filter.UpdatedTo != nil {
+		add("t.updated_at <= $%d", *filter.UpdatedTo)
+	}
+	if filter.Title != "" {
+		add(`t.title ILIKE $%d ESCAPE '\'`, "%"+likeEscaper.Replace(filter.Title)+"%")
+	}
+
+	if len(filter.Tags) > 0 {
+		tagQuery := "SELECT %s FROM task_tags tt INNER JOIN tags tg ON tg.id = tt.tag_id WHERE tt.task_id = t.id AND tg.name = ANY($%d)"
+		args = append(args, pq.Array(filter.Tags))
+		if filter.TagMode == domain.TagMatchAll {
+			args = append(args, len(uniqueStrings(filter.Tags)))
+			where = append(where, fmt.Sprintf("("+tagQuery+") = $%d", "COUNT(DISTINCT tg.name)", len(args)-1, len(args)))
+		} else {
+			where = append(where, fmt.Sprintf("EXISTS ("+tagQuery+")", "1", len(args)))
+		}
+	}
+
+	return where, args
+}
+
+func uniqueStrings(values []string) []string {
+	seen := map[string]bool{}
+	unique := []string{}
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 type PostgresAssignmentRepository struct {
 	db *sqlx.DB
 }
@@ -68,14 +281,10 @@ func NewPostgresAssignmentRepository(db *sqlx.DB) domain.AssignmentRepository {
 	return &PostgresAssignmentRepository{db: db}
 }
 
-func (r *PostgresAssignmentRepository) BeginTx(ctx context.Context) (*sqlx.Tx, error) {
-	return r.db.BeginTxx(ctx, nil)
-}
-
 func (r *PostgresAssignmentRepository) Create(ctx context.Context, tx *sqlx.Tx, assignment *domain.TaskAssignment) error {
 	query := `
-		INSERT INTO task_assignments (id, task_id, user_id, created_at)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO task_assignments (id, task_id, user_id, created_at, team_id)
+		VALUES ($1, $2, $3, $4, $5)
 	`
 
 	var executor sqlx.ExtContext = r.db
@@ -84,14 +293,36 @@ func (r *PostgresAssignmentRepository) Create(ctx context.Context, tx *sqlx.Tx,
 	}
 
 	_, err := executor.ExecContext(ctx, query,
-		assignment.ID, assignment.TaskID, assignment.UserID, assignment.CreatedAt)
+		assignment.ID, assignment.TaskID, assignment.UserID, assignment.CreatedAt, assignment.TeamID)
 	return err
 }
 
+func (r *PostgresAssignmentRepository) GetByID(ctx context.Context, assignmentID string) (*domain.TaskAssignment, error) {
+	assignment := &domain.TaskAssignment{}
+	query := `SELECT id, task_id, user_id, created_at, team_id FROM task_assignments WHERE id = $1`
+	err := r.db.GetContext(ctx, assignment, query, assignmentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return assignment, nil
+}
+
 func (r *PostgresAssignmentRepository) GetByTask(ctx context.Context, taskID string) ([]domain.TaskAssignment, error) {
+	return r.GetByTaskTx(ctx, nil, taskID)
+}
+
+func (r *PostgresAssignmentRepository) GetByTaskTx(ctx context.Context, tx *sqlx.Tx, taskID string) ([]domain.TaskAssignment, error) {
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
 	assignments := []domain.TaskAssignment{}
-	query := `SELECT id, task_id, user_id, created_at FROM task_assignments WHERE task_id = $1`
-	err := r.db.SelectContext(ctx, &assignments, query, taskID)
+	query := `SELECT id, task_id, user_id, created_at, team_id FROM task_assignments WHERE task_id = $1`
+	err := sqlx.SelectContext(ctx, executor, &assignments, query, taskID)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +331,7 @@ func (r *PostgresAssignmentRepository) GetByTask(ctx context.Context, taskID str
 
 func (r *PostgresAssignmentRepository) GetByUser(ctx context.Context, userID string) ([]domain.TaskAssignment, error) {
 	assignments := []domain.TaskAssignment{}
-	query := `SELECT id, task_id, user_id, created_at FROM task_assignments WHERE user_id = $1`
+	query := `SELECT id, task_id, user_id, created_at, team_id FROM task_assignments WHERE user_id = $1`
 	err := r.db.SelectContext(ctx, &assignments, query, userID)
 	if err != nil {
 		return nil, err
@@ -108,12 +339,58 @@ func (r *PostgresAssignmentRepository) GetByUser(ctx context.Context, userID str
 	return assignments, nil
 }
 
-func (r *PostgresAssignmentRepository) Delete(ctx context.Context, assignmentID string) error {
-	query := `DELETE FROM task_assignments WHERE id = $1`
-	_, err := r.db.ExecContext(ctx, query, assignmentID)
+func (r *PostgresAssignmentRepository) ListOpenByUser(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID) ([]domain.OpenAssignment, error) {
+	query := `
+		SELECT ta.id, ta.task_id, ta.user_id, ta.created_at, ta.team_id, t.title AS task_title
+		FROM task_assignments ta
+		INNER JOIN tasks t ON t.id = ta.task_id
+		LEFT JOIN workflow_states ws ON ws.key = t.status
+		WHERE ta.user_id = $1 AND t.deleted_at IS NULL AND ` + openTaskCondition + `
+		ORDER BY ta.created_at ASC
+		FOR UPDATE OF ta
+	`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	assignments := []domain.OpenAssignment{}
+	if err := sqlx.SelectContext(ctx, executor, &assignments, query, userID); err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+// Reassign yeni kullanıcı takımın üyesi olmayabileceğinden atamanın takım bağını kaldırır;
+// task'ın takım ataması etkilenmez.
+func (r *PostgresAssignmentRepository) Reassign(ctx context.Context, tx *sqlx.Tx, assignmentID, userID uuid.UUID) error {
+	query := `UPDATE task_assignments SET user_id = $2, team_id = NULL WHERE id = $1`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query, assignmentID, userID)
 	return err
 }
 
+func (r *PostgresAssignmentRepository) Delete(ctx context.Context, tx *sqlx.Tx, assignmentID string) error {
+	query := `DELETE FROM task_assignments WHERE id = $1`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	res, err := executor.ExecContext(ctx, query, assignmentID)
+	if err != nil {
+		return err
+	}
+	return requireAffectedAs(res, domain.ErrAssignmentNotFound{})
+}
+
 type PostgresScopeRepository struct {
 	db *sqlx.DB
 }
@@ -122,25 +399,38 @@ func NewPostgresScopeRepository(db *sqlx.DB) domain.ScopeRepository {
 	return &PostgresScopeRepository{db: db}
 }
 
-func (r *PostgresScopeRepository) AddToAssignment(ctx context.Context, assignmentID string, scopeID string) error {
-	query := `INSERT INTO assignment_scopes (assignment_id, scope_id) VALUES ($1, $2)`
-	_, err := r.db.ExecContext(ctx, query, assignmentID, scopeID)
+func (r *PostgresScopeRepository) AddToAssignment(ctx context.Context, tx *sqlx.Tx, assignmentID string, scopeID string) error {
+	query := `INSERT INTO assignment_scopes (assignment_id, scope_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query, assignmentID, scopeID)
 	return err
 }
 
-func (r *PostgresScopeRepository) RemoveFromAssignment(ctx context.Context, assignmentID string, scopeID string) error {
+func (r *PostgresScopeRepository) RemoveFromAssignment(ctx context.Context, tx *sqlx.Tx, assignmentID string, scopeID string) error {
 	query := `DELETE FROM assignment_scopes WHERE assignment_id = $1 AND scope_id = $2`
-	_, err := r.db.ExecContext(ctx, query, assignmentID, scopeID)
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query, assignmentID, scopeID)
 	return err
 }
 
 func (r *PostgresScopeRepository) GetByAssignment(ctx context.Context, assignmentID string) ([]domain.Scope, error) {
 	scopes := []domain.Scope{}
 	query := `
-		SELECT s.id, s.name
+		SELECT s.id, s.name, s.permissions
 		FROM scopes s
-		INNER JOIN assignment_scopes as ON as.scope_id = s.id
-		WHERE as.assignment_id = $1
+		INNER JOIN assignment_scopes asg ON asg.scope_id = s.id
+		WHERE asg.assignment_id = $1
+		ORDER BY s.name ASC
 	`
 	err := r.db.SelectContext(ctx, &scopes, query, assignmentID)
 	if err != nil {
@@ -149,6 +439,34 @@ func (r *PostgresScopeRepository) GetByAssignment(ctx context.Context, assignmen
 	return scopes, nil
 }
 
+func (r *PostgresScopeRepository) GetByAssignments(ctx context.Context, assignmentIDs []string) (map[string][]domain.Scope, error) {
+	result := map[string][]domain.Scope{}
+	if len(assignmentIDs) == 0 {
+		return result, nil
+	}
+
+	rows := []struct {
+		AssignmentID string `db:"assignment_id"`
+		domain.Scope
+	}{}
+	query := `
+		SELECT asg.assignment_id, s.id, s.name, s.permissions
+		FROM scopes s
+		INNER JOIN assignment_scopes asg ON asg.scope_id = s.id
+		WHERE asg.assignment_id = ANY($1::uuid[])
+		ORDER BY s.name ASC
+	`
+	err := r.db.SelectContext(ctx, &rows, query, pq.Array(assignmentIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.AssignmentID] = append(result[row.AssignmentID], row.Scope)
+	}
+	return result, nil
+}
+
 type PostgresScopeLookupRepository struct {
 	db *sqlx.DB
 }
@@ -159,7 +477,7 @@ func NewPostgresScopeLookupRepository(db *sqlx.DB) domain.ScopeLookupRepository
 
 func (r *PostgresScopeLookupRepository) GetAll(ctx context.Context) ([]domain.Scope, error) {
 	scopes := []domain.Scope{}
-	query := `SELECT id, name FROM scopes ORDER BY name ASC`
+	query := `SELECT id, name, permissions FROM scopes ORDER BY name ASC`
 	err := r.db.SelectContext(ctx, &scopes, query)
 	if err != nil {
 		return nil, err
@@ -169,7 +487,7 @@ func (r *PostgresScopeLookupRepository) GetAll(ctx context.Context) ([]domain.Sc
 
 func (r *PostgresScopeLookupRepository) GetByID(ctx context.Context, scopeID string) (*domain.Scope, error) {
 	scope := &domain.Scope{}
-	query := `SELECT id, name FROM scopes WHERE id = $1`
+	query := `SELECT id, name, permissions FROM scopes WHERE id = $1`
 	err := r.db.GetContext(ctx, scope, query, scopeID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -180,6 +498,30 @@ func (r *PostgresScopeLookupRepository) GetByID(ctx context.Context, scopeID str
 	return scope, nil
 }
 
+func (r *PostgresScopeLookupRepository) Create(ctx context.Context, scope *domain.Scope) error {
+	query := `INSERT INTO scopes (id, name, permissions) VALUES ($1, $2, $3)`
+	_, err := r.db.ExecContext(ctx, query, scope.ID, scope.Name, scope.Permissions)
+	return err
+}
+
+func (r *PostgresScopeLookupRepository) Update(ctx context.Context, scope *domain.Scope) error {
+	query := `UPDATE scopes SET name = $1, permissions = $2 WHERE id = $3`
+	res, err := r.db.ExecContext(ctx, query, scope.Name, scope.Permissions, scope.ID)
+	if err != nil {
+		return err
+	}
+	return requireAffectedAs(res, domain.ErrScopeNotFound{})
+}
+
+func (r *PostgresScopeLookupRepository) Delete(ctx context.Context, scopeID string) error {
+	query := `DELETE FROM scopes WHERE id = $1`
+	res, err := r.db.ExecContext(ctx, query, scopeID)
+	if err != nil {
+		return err
+	}
+	return requireAffectedAs(res, domain.ErrScopeNotFound{})
+}
+
 type PostgresActivityRepository struct {
 	db *sqlx.DB
 }
@@ -188,30 +530,56 @@ func NewPostgresActivityRepository(db *sqlx.DB) domain.ActivityRepository {
 	return &PostgresActivityRepository{db: db}
 }
 
-func (r *PostgresActivityRepository) Create(ctx context.Context, activity *domain.Activity) error {
+func (r *PostgresActivityRepository) Create(ctx context.Context, tx *sqlx.Tx, activity *domain.Activity) error {
 	query := `
-		INSERT INTO task_activities (id, task_id, user_id, action, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO task_activities (id, task_id, user_id, action, changes, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
 	`
-	_, err := r.db.ExecContext(ctx, query,
-		activity.ID, activity.TaskID, activity.UserID, activity.Action, activity.CreatedAt)
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query,
+		activity.ID, activity.TaskID, activity.UserID, activity.Action, activity.Changes, activity.CreatedAt)
 	return err
 }
 
-func (r *PostgresActivityRepository) GetByTask(ctx context.Context, taskID string) ([]domain.Activity, error) {
-	activities := []domain.Activity{}
-	query := `SELECT id, task_id, user_id, action, created_at FROM task_activities WHERE task_id = $1 ORDER BY created_at DESC`
-	err := r.db.SelectContext(ctx, &activities, query, taskID)
-	if err != nil {
-		return nil, err
-	}
-	return activities, nil
+func (r *PostgresActivityRepository) GetByTask(ctx context.Context, taskID string, filter *domain.ActivityFilter) ([]domain.Activity, error) {
+	return r.list(ctx, "task_id", taskID, filter)
 }
 
-func (r *PostgresActivityRepository) GetByUser(ctx context.Context, userID string) ([]domain.Activity, error) {
+func (r *PostgresActivityRepository) GetByUser(ctx context.Context, userID string, filter *domain.ActivityFilter) ([]domain.Activity, error) {
+	return r.list(ctx, "user_id", userID, filter)
+}
+
+// list aktiviteleri created_at DESC, id DESC sırasıyla keyset pagination ile döner.
+func (r *PostgresActivityRepository) list(ctx context.Context, column string, value string, filter *domain.ActivityFilter) ([]domain.Activity, error) {
+	where := []string{column + " = $1"}
+	args := []interface{}{value}
+
+	if len(filter.Actions) > 0 {
+		actions := make([]string, 0, len(filter.Actions))
+		for _, action := range filter.Actions {
+			actions = append(actions, string(action))
+		}
+		args = append(args, pq.Array(actions))
+		where = append(where, fmt.Sprintf("action = ANY($%d)", len(args)))
+	}
+
+	if filter.After != nil {
+		args = append(args, filter.After.CreatedAt, filter.After.ID)
+		where = append(where, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	args = append(args, filter.Limit)
+	query := `SELECT id, task_id, user_id, action, changes, created_at FROM task_activities WHERE ` +
+		strings.Join(where, " AND ") +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", len(args))
+
 	activities := []domain.Activity{}
-	query := `SELECT id, task_id, user_id, action, created_at FROM task_activities WHERE user_id = $1 ORDER BY created_at DESC`
-	err := r.db.SelectContext(ctx, &activities, query, userID)
+	err := r.db.SelectContext(ctx, &activities, query, args...)
 	if err != nil {
 		return nil, err
 	}
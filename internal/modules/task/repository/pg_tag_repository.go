@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type PostgresTagRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresTagRepository(db *sqlx.DB) domain.TagRepository {
+	return &PostgresTagRepository{db: db}
+}
+
+const tagWithUsageQuery = `
+	SELECT tg.id, tg.name, COUNT(t.id) AS usage_count
+	FROM tags tg
+	LEFT JOIN task_tags tt ON tt.tag_id = tg.id
+	LEFT JOIN tasks t ON t.id = tt.task_id AND t.deleted_at IS NULL
+`
+
+func (r *PostgresTagRepository) GetAll(ctx context.Context) ([]domain.Tag, error) {
+	tags := []domain.Tag{}
+	query := tagWithUsageQuery + ` GROUP BY tg.id, tg.name ORDER BY tg.name ASC`
+	err := r.db.SelectContext(ctx, &tags, query)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+func (r *PostgresTagRepository) GetByID(ctx context.Context, tagID string) (*domain.Tag, error) {
+	tag := &domain.Tag{}
+	query := tagWithUsageQuery + ` WHERE tg.id = $1 GROUP BY tg.id, tg.name`
+	err := r.db.GetContext(ctx, tag, query, tagID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (r *PostgresTagRepository) Create(ctx context.Context, tag *domain.Tag) error {
+	query := `INSERT INTO tags (id, name) VALUES ($1, $2)`
+	_, err := r.db.ExecContext(ctx, query, tag.ID, tag.Name)
+	return err
+}
+
+func (r *PostgresTagRepository) Rename(ctx context.Context, tagID string, name string) error {
+	query := `UPDATE tags SET name = $1 WHERE id = $2`
+	res, err := r.db.ExecContext(ctx, query, name, tagID)
+	if err != nil {
+		return err
+	}
+	return requireAffectedAs(res, domain.ErrTagNotFound{})
+}
+
+func (r *PostgresTagRepository) Delete(ctx context.Context, tagID string) error {
+	query := `DELETE FROM tags WHERE id = $1`
+	res, err := r.db.ExecContext(ctx, query, tagID)
+	if err != nil {
+		return err
+	}
+	return requireAffectedAs(res, domain.ErrTagNotFound{})
+}
+
+func (r *PostgresTagRepository) Merge(ctx context.Context, tx *sqlx.Tx, sourceID string, targetID string) ([]uuid.UUID, error) {
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	taskIDs := []uuid.UUID{}
+	if err := sqlx.SelectContext(ctx, executor, &taskIDs, `SELECT task_id FROM task_tags WHERE tag_id = $1`, sourceID); err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO task_tags (task_id, tag_id)
+		SELECT task_id, $2 FROM task_tags WHERE tag_id = $1
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := executor.ExecContext(ctx, query, sourceID, targetID); err != nil {
+		return nil, err
+	}
+
+	res, err := executor.ExecContext(ctx, `DELETE FROM tags WHERE id = $1`, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireAffectedAs(res, domain.ErrTagNotFound{}); err != nil {
+		return nil, err
+	}
+
+	return taskIDs, nil
+}
+
+func (r *PostgresTagRepository) AddToTask(ctx context.Context, tx *sqlx.Tx, taskID string, tagID string) error {
+	query := `INSERT INTO task_tags (task_id, tag_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query, taskID, tagID)
+	return err
+}
+
+func (r *PostgresTagRepository) RemoveFromTask(ctx context.Context, tx *sqlx.Tx, taskID string, tagID string) error {
+	query := `DELETE FROM task_tags WHERE task_id = $1 AND tag_id = $2`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	res, err := executor.ExecContext(ctx, query, taskID, tagID)
+	if err != nil {
+		return err
+	}
+	return requireAffectedAs(res, domain.ErrTagNotFound{})
+}
+
+func (r *PostgresTagRepository) GetByTask(ctx context.Context, taskID string) ([]domain.Tag, error) {
+	tags := []domain.Tag{}
+	query := tagWithUsageQuery + `
+		WHERE tg.id IN (SELECT tag_id FROM task_tags WHERE task_id = $1)
+		GROUP BY tg.id, tg.name
+		ORDER BY tg.name ASC
+	`
+	err := r.db.SelectContext(ctx, &tags, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const teamAssignmentColumns = `tta.id, tta.task_id, tta.team_id, tm.name AS team_name, tta.assigned_by, tta.created_at`
+
+type PostgresTeamAssignmentRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresTeamAssignmentRepository(db *sqlx.DB) domain.TeamAssignmentRepository {
+	return &PostgresTeamAssignmentRepository{db: db}
+}
+
+func (r *PostgresTeamAssignmentRepository) Create(ctx context.Context, tx *sqlx.Tx, assignment *domain.TeamAssignment) error {
+	query := `
+		INSERT INTO task_team_assignments (id, task_id, team_id, assigned_by, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	_, err := executor.ExecContext(ctx, query,
+		assignment.ID, assignment.TaskID, assignment.TeamID, assignment.AssignedBy, assignment.CreatedAt)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return domain.ErrTeamAlreadyAssigned{}
+	}
+	return err
+}
+
+func (r *PostgresTeamAssignmentRepository) GetByID(ctx context.Context, assignmentID string) (*domain.TeamAssignment, error) {
+	assignment := &domain.TeamAssignment{}
+	query := `SELECT ` + teamAssignmentColumns + ` FROM task_team_assignments tta INNER JOIN teams tm ON tm.id = tta.team_id WHERE tta.id = $1`
+	err := r.db.GetContext(ctx, assignment, query, assignmentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return assignment, nil
+}
+
+func (r *PostgresTeamAssignmentRepository) GetByTask(ctx context.Context, taskID string) ([]domain.TeamAssignment, error) {
+	assignments := []domain.TeamAssignment{}
+	query := `
+		SELECT ` + teamAssignmentColumns + `
+		FROM task_team_assignments tta
+		INNER JOIN teams tm ON tm.id = tta.team_id
+		WHERE tta.task_id = $1
+		ORDER BY tta.created_at ASC
+	`
+	if err := r.db.SelectContext(ctx, &assignments, query, taskID); err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+func (r *PostgresTeamAssignmentRepository) Delete(ctx context.Context, tx *sqlx.Tx, assignmentID string) error {
+	query := `DELETE FROM task_team_assignments WHERE id = $1`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	res, err := executor.ExecContext(ctx, query, assignmentID)
+	if err != nil {
+		return err
+	}
+	return requireAffectedAs(res, domain.ErrTeamAssignmentNotFound{})
+}
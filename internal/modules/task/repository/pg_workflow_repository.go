@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type PostgresWorkflowRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresWorkflowRepository(db *sqlx.DB) domain.WorkflowRepository {
+	return &PostgresWorkflowRepository{db: db}
+}
+
+func (r *PostgresWorkflowRepository) GetWorkflow(ctx context.Context) (*domain.Workflow, error) {
+	workflow := &domain.Workflow{
+		States:      []domain.WorkflowState{},
+		Transitions: []domain.WorkflowTransition{},
+	}
+
+	query := `SELECT key, name, is_initial, is_final, position FROM workflow_states ORDER BY position ASC, key ASC`
+	if err := r.db.SelectContext(ctx, &workflow.States, query); err != nil {
+		return nil, err
+	}
+
+	query = `SELECT id, from_state, to_state, required_role FROM workflow_transitions ORDER BY from_state ASC, to_state ASC`
+	if err := r.db.SelectContext(ctx, &workflow.Transitions, query); err != nil {
+		return nil, err
+	}
+
+	return workflow, nil
+}
+
+func (r *PostgresWorkflowRepository) GetState(ctx context.Context, key domain.TaskStatus) (*domain.WorkflowState, error) {
+	state := &domain.WorkflowState{}
+	query := `SELECT key, name, is_initial, is_final, position FROM workflow_states WHERE key = $1`
+	err := r.db.GetContext(ctx, state, query, key)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return state, nil
+}
+
+func (r *PostgresWorkflowRepository) GetInitialState(ctx context.Context) (*domain.WorkflowState, error) {
+	state := &domain.WorkflowState{}
+	query := `SELECT key, name, is_initial, is_final, position FROM workflow_states WHERE is_initial = TRUE`
+	err := r.db.GetContext(ctx, state, query)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return state, nil
+}
+
+func (r *PostgresWorkflowRepository) GetTransition(ctx context.Context, from, to domain.TaskStatus) (*domain.WorkflowTransition, error) {
+	transition := &domain.WorkflowTransition{}
+	query := `SELECT id, from_state, to_state, required_role FROM workflow_transitions WHERE from_state = $1 AND to_state = $2`
+	err := r.db.GetContext(ctx, transition, query, from, to)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return transition, nil
+}
+
+func (r *PostgresWorkflowRepository) CreateState(ctx context.Context, state *domain.WorkflowState) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Tek bir başlangıç durumu olabilir; yeni durum başlangıç ise eskisi devreden çıkar.
+	if state.IsInitial {
+		if _, err := tx.ExecContext(ctx, `UPDATE workflow_states SET is_initial = FALSE WHERE is_initial = TRUE`); err != nil {
+			return err
+		}
+	}
+
+	query := `
+		INSERT INTO workflow_states (key, name, is_initial, is_final, position)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	if _, err := tx.ExecContext(ctx, query,
+		state.Key, state.Name, state.IsInitial, state.IsFinal, state.Position); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresWorkflowRepository) DeleteState(ctx context.Context, key domain.TaskStatus) error {
+	query := `DELETE FROM workflow_states WHERE key = $1 AND is_initial = FALSE`
+	res, err := r.db.ExecContext(ctx, query, key)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return domain.ErrWorkflowStateInUse{}
+		}
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		state, err := r.GetState(ctx, key)
+		if err != nil {
+			return err
+		}
+		if state != nil {
+			return domain.ErrWorkflowStateInUse{}
+		}
+		return domain.ErrWorkflowNotFound{}
+	}
+	return nil
+}
+
+func (r *PostgresWorkflowRepository) CreateTransition(ctx context.Context, transition *domain.WorkflowTransition) error {
+	query := `
+		INSERT INTO workflow_transitions (id, from_state, to_state, required_role)
+		VALUES ($1, $2, $3, $4)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		transition.ID, transition.FromState, transition.ToState, transition.RequiredRole)
+	return err
+}
+
+func (r *PostgresWorkflowRepository) DeleteTransition(ctx context.Context, transitionID string) error {
+	query := `DELETE FROM workflow_transitions WHERE id = $1`
+	res, err := r.db.ExecContext(ctx, query, transitionID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrWorkflowNotFound{}
+	}
+	return nil
+}
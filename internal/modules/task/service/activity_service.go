@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/google/uuid"
+)
+
+type ActivityService interface {
+	ListTaskActivities(ctx context.Context, taskID string, filter *domain.ActivityFilter) (*domain.ActivityPage, error)
+	ListUserActivities(ctx context.Context, userID string, filter *domain.ActivityFilter) (*domain.ActivityPage, error)
+}
+
+type activityService struct {
+	activityRepo domain.ActivityRepository
+	taskRepo     domain.TaskRepository
+	userProvider domain.UserProvider
+	logger       logger.Logger
+}
+
+func NewActivityService(
+	activityRepo domain.ActivityRepository,
+	taskRepo domain.TaskRepository,
+	userProvider domain.UserProvider,
+	logger logger.Logger,
+) ActivityService {
+	return &activityService{
+		activityRepo: activityRepo,
+		taskRepo:     taskRepo,
+		userProvider: userProvider,
+		logger:       logger,
+	}
+}
+
+func (s *activityService) ListTaskActivities(ctx context.Context, taskID string, filter *domain.ActivityFilter) (*domain.ActivityPage, error) {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		s.logger.Error("Failed to get task", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return nil, err
+	}
+	if task == nil {
+		return nil, domain.ErrTaskNotFound{}
+	}
+
+	return s.list(ctx, filter, func(f *domain.ActivityFilter) ([]domain.Activity, error) {
+		return s.activityRepo.GetByTask(ctx, taskID, f)
+	})
+}
+
+func (s *activityService) ListUserActivities(ctx context.Context, userID string, filter *domain.ActivityFilter) (*domain.ActivityPage, error) {
+	return s.list(ctx, filter, func(f *domain.ActivityFilter) ([]domain.Activity, error) {
+		return s.activityRepo.GetByUser(ctx, userID, f)
+	})
+}
+
+func (s *activityService) list(ctx context.Context, filter *domain.ActivityFilter, fetch func(*domain.ActivityFilter) ([]domain.Activity, error)) (*domain.ActivityPage, error) {
+	filter.Normalize()
+
+	if filter.Cursor != "" {
+		cursor, err := domain.DecodeActivityCursor(filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = cursor
+	}
+
+	// Bir sonraki sayfanın varlığını anlamak için limit+1 kayıt istenir.
+	limit := filter.Limit
+	filter.Limit = limit + 1
+	activities, err := fetch(filter)
+	filter.Limit = limit
+	if err != nil {
+		s.logger.Error("Failed to list activities", err, nil)
+		return nil, err
+	}
+
+	page := &domain.ActivityPage{Items: []domain.ActivityView{}}
+	if len(activities) > limit {
+		activities = activities[:limit]
+		last := activities[len(activities)-1]
+		page.NextCursor = (&domain.ActivityCursor{CreatedAt: last.CreatedAt, ID: last.ID}).Encode()
+	}
+
+	usernames := map[uuid.UUID]string{}
+	for i := range activities {
+		activity := &activities[i]
+		username, ok := usernames[activity.UserID]
+		if !ok {
+			username = activity.UserID.String()
+			if user, err := s.userProvider.GetUserByID(ctx, activity.UserID); err == nil && user != nil {
+				username = user.Username
+			}
+			usernames[activity.UserID] = username
+		}
+
+		page.Items = append(page.Items, domain.ActivityView{
+			Activity: *activity,
+			Username: username,
+			Summary:  domain.SummarizeActivity(activity, username),
+		})
+	}
+
+	return page, nil
+}
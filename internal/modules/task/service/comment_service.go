@@ -0,0 +1,404 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/events"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/database"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/outbox"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const commentExcerptLength = 140
+
+type CommentService interface {
+	ListComments(ctx context.Context, taskID string) ([]domain.Comment, error)
+	CreateComment(ctx context.Context, taskID string, req *domain.CreateCommentRequest) (*domain.Comment, error)
+	UpdateComment(ctx context.Context, taskID string, commentID string, req *domain.UpdateCommentRequest) (*domain.Comment, error)
+	DeleteComment(ctx context.Context, taskID string, commentID string) error
+	GetCommentHistory(ctx context.Context, taskID string, commentID string) ([]domain.CommentEdit, error)
+}
+
+type commentService struct {
+	commentRepo  domain.CommentRepository
+	taskRepo     domain.TaskRepository
+	activityRepo domain.ActivityRepository
+	userProvider domain.UserProvider
+	outboxRepo   outbox.Repository
+	uow          database.UnitOfWork
+	logger       logger.Logger
+}
+
+func NewCommentService(
+	commentRepo domain.CommentRepository,
+	taskRepo domain.TaskRepository,
+	activityRepo domain.ActivityRepository,
+	userProvider domain.UserProvider,
+	outboxRepo outbox.Repository,
+	uow database.UnitOfWork,
+	logger logger.Logger,
+) CommentService {
+	return &commentService{
+		commentRepo:  commentRepo,
+		taskRepo:     taskRepo,
+		activityRepo: activityRepo,
+		userProvider: userProvider,
+		outboxRepo:   outboxRepo,
+		uow:          uow,
+		logger:       logger,
+	}
+}
+
+// ListComments yorumları thread halinde döner: kök yorumlar oluşturulma sırasıyla, yanıtlar
+// ise ebeveynlerinin Replies alanında yer alır. Silinmiş yorumların metni gizlenir.
+func (s *commentService) ListComments(ctx context.Context, taskID string) ([]domain.Comment, error) {
+	if _, err := s.getTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+
+	comments, err := s.commentRepo.GetByTask(ctx, taskID)
+	if err != nil {
+		s.logger.Error("Failed to list comments", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return nil, err
+	}
+
+	return buildCommentTree(comments), nil
+}
+
+func (s *commentService) CreateComment(ctx context.Context, taskID string, req *domain.CreateCommentRequest) (*domain.Comment, error) {
+	task, err := s.getTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &domain.Comment{
+		ID:        uuid.New(),
+		TaskID:    task.ID,
+		AuthorID:  currentUserID(ctx),
+		Body:      strings.TrimSpace(req.Body),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Replies:   []domain.Comment{},
+	}
+
+	if req.ParentID != "" {
+		parent, err := s.commentRepo.GetByID(ctx, req.ParentID)
+		if err != nil {
+			s.logger.Error("Failed to get parent comment", err, map[string]interface{}{
+				"comment_id": req.ParentID,
+			})
+			return nil, err
+		}
+		if parent == nil || parent.TaskID != task.ID {
+			return nil, domain.ErrInvalidParentComment{}
+		}
+		comment.ParentID = &parent.ID
+	}
+
+	mentioned := s.resolveMentions(ctx, comment.Body)
+	comment.Mentions = mentionIDs(mentioned)
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.commentRepo.Create(ctx, tx, comment); err != nil {
+			return err
+		}
+
+		activity := newActivity(task.ID, comment.AuthorID, domain.ActivityCommentAdded, domain.ActivityMetadata(map[string]any{
+			"comment_id": comment.ID,
+		}))
+		if err := s.activityRepo.Create(ctx, tx, activity); err != nil {
+			return err
+		}
+
+		return s.notifyMentions(ctx, tx, task, comment, mentioned)
+	})
+	if err != nil {
+		s.logger.Error("Failed to create comment", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return nil, err
+	}
+
+	s.logger.Info("Comment created", map[string]interface{}{
+		"action":     "TASK_COMMENT_CREATE",
+		"task_id":    taskID,
+		"comment_id": comment.ID.String(),
+		"mentions":   len(mentioned),
+	})
+
+	return comment, nil
+}
+
+// UpdateComment yorum metnini günceller ve önceki metni düzenleme geçmişine yazar. Mention
+// bildirimi yalnızca bu düzenlemeyle yeni anılan kullanıcılara gönderilir.
+func (s *commentService) UpdateComment(ctx context.Context, taskID string, commentID string, req *domain.UpdateCommentRequest) (*domain.Comment, error) {
+	task, comment, err := s.getOwnComment(ctx, taskID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == comment.Body {
+		return comment, nil
+	}
+
+	previous := map[string]bool{}
+	for _, id := range comment.Mentions {
+		previous[id] = true
+	}
+
+	mentioned := s.resolveMentions(ctx, body)
+	newlyMentioned := []domain.UserInfo{}
+	for _, user := range mentioned {
+		if !previous[user.ID.String()] {
+			newlyMentioned = append(newlyMentioned, user)
+		}
+	}
+
+	editorID := currentUserID(ctx)
+	now := time.Now()
+	edit := &domain.CommentEdit{
+		ID:        uuid.New(),
+		CommentID: comment.ID,
+		Body:      comment.Body,
+		EditedBy:  editorID,
+		EditedAt:  now,
+	}
+
+	comment.Body = body
+	comment.Mentions = mentionIDs(mentioned)
+	comment.EditedAt = &now
+	comment.UpdatedAt = now
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.commentRepo.CreateEdit(ctx, tx, edit); err != nil {
+			return err
+		}
+		if err := s.commentRepo.Update(ctx, tx, comment); err != nil {
+			return err
+		}
+
+		activity := newActivity(task.ID, editorID, domain.ActivityCommentEdited, domain.ActivityMetadata(map[string]any{
+			"comment_id": comment.ID,
+		}))
+		if err := s.activityRepo.Create(ctx, tx, activity); err != nil {
+			return err
+		}
+
+		return s.notifyMentions(ctx, tx, task, comment, newlyMentioned)
+	})
+	if err != nil {
+		s.logger.Error("Failed to update comment", err, map[string]interface{}{
+			"comment_id": commentID,
+		})
+		return nil, err
+	}
+
+	s.logger.Info("Comment updated", map[string]interface{}{
+		"action":     "TASK_COMMENT_UPDATE",
+		"task_id":    taskID,
+		"comment_id": commentID,
+	})
+
+	return comment, nil
+}
+
+func (s *commentService) DeleteComment(ctx context.Context, taskID string, commentID string) error {
+	task, comment, err := s.getOwnComment(ctx, taskID, commentID)
+	if err != nil {
+		return err
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.commentRepo.SoftDelete(ctx, tx, commentID); err != nil {
+			return err
+		}
+		return s.activityRepo.Create(ctx, tx, newActivity(task.ID, currentUserID(ctx), domain.ActivityCommentDeleted, domain.ActivityMetadata(map[string]any{
+			"comment_id": comment.ID,
+		})))
+	})
+	if err != nil {
+		s.logger.Error("Failed to delete comment", err, map[string]interface{}{
+			"comment_id": commentID,
+		})
+		return err
+	}
+
+	s.logger.Info("Comment deleted", map[string]interface{}{
+		"action":     "TASK_COMMENT_DELETE",
+		"task_id":    taskID,
+		"comment_id": commentID,
+	})
+
+	return nil
+}
+
+func (s *commentService) GetCommentHistory(ctx context.Context, taskID string, commentID string) ([]domain.CommentEdit, error) {
+	task, err := s.getTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		s.logger.Error("Failed to get comment", err, map[string]interface{}{
+			"comment_id": commentID,
+		})
+		return nil, err
+	}
+	if comment == nil || comment.TaskID != task.ID {
+		return nil, domain.ErrCommentNotFound{}
+	}
+
+	edits, err := s.commentRepo.GetEdits(ctx, commentID)
+	if err != nil {
+		s.logger.Error("Failed to get comment history", err, map[string]interface{}{
+			"comment_id": commentID,
+		})
+		return nil, err
+	}
+	return edits, nil
+}
+
+func (s *commentService) getTask(ctx context.Context, taskID string) (*domain.Task, error) {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		s.logger.Error("Failed to get task", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return nil, err
+	}
+	if task == nil {
+		return nil, domain.ErrTaskNotFound{}
+	}
+	return task, nil
+}
+
+// getOwnComment yorumu task altında arar ve çağıranın yorumun sahibi ya da ADMIN olduğunu doğrular.
+func (s *commentService) getOwnComment(ctx context.Context, taskID string, commentID string) (*domain.Task, *domain.Comment, error) {
+	task, err := s.getTask(ctx, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment, err := s.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		s.logger.Error("Failed to get comment", err, map[string]interface{}{
+			"comment_id": commentID,
+		})
+		return nil, nil, err
+	}
+	if comment == nil || comment.TaskID != task.ID {
+		return nil, nil, domain.ErrCommentNotFound{}
+	}
+
+	if comment.AuthorID.String() != utils.GetUserIDFromContext(ctx) && utils.GetRoleFromContext(ctx) != "ADMIN" {
+		return nil, nil, domain.ErrCommentForbidden{}
+	}
+
+	return task, comment, nil
+}
+
+// resolveMentions metindeki @username ifadelerini UserProvider üzerinden çözer. Bulunamayan
+// kullanıcı adları sessizce atlanır.
+func (s *commentService) resolveMentions(ctx context.Context, body string) []domain.UserInfo {
+	users := []domain.UserInfo{}
+	for _, username := range domain.ParseMentions(body) {
+		user, err := s.userProvider.GetUserByUsername(ctx, username)
+		if err != nil {
+			s.logger.Error("Failed to resolve mention", err, map[string]interface{}{
+				"username": username,
+			})
+			continue
+		}
+		if user != nil {
+			users = append(users, *user)
+		}
+	}
+	return users
+}
+
+func (s *commentService) notifyMentions(ctx context.Context, tx *sqlx.Tx, task *domain.Task, comment *domain.Comment, mentioned []domain.UserInfo) error {
+	recipients := []events.Recipient{}
+	for _, user := range mentioned {
+		if user.ID == comment.AuthorID {
+			continue
+		}
+		recipients = append(recipients, events.Recipient{
+			UserID:    user.ID.String(),
+			UserName:  user.Username,
+			UserEmail: user.Email,
+		})
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	event := events.CommentMentionEvent{
+		TaskID:     task.ID.String(),
+		TaskTitle:  task.Title,
+		CommentID:  comment.ID.String(),
+		AuthorID:   comment.AuthorID.String(),
+		AuthorName: utils.GetUsernameFromContext(ctx),
+		Excerpt:    excerpt(comment.Body, commentExcerptLength),
+		Recipients: recipients,
+	}
+	return writeTaskOutbox(ctx, s.outboxRepo, s.logger, tx, task.ID, events.TopicCommentMention, event)
+}
+
+func buildCommentTree(comments []domain.Comment) []domain.Comment {
+	children := map[uuid.UUID][]int{}
+	roots := []int{}
+	for i := range comments {
+		if comments[i].DeletedAt != nil {
+			comments[i].Body = ""
+			comments[i].Mentions = pq.StringArray{}
+		}
+		if comments[i].ParentID == nil {
+			roots = append(roots, i)
+		} else {
+			children[*comments[i].ParentID] = append(children[*comments[i].ParentID], i)
+		}
+	}
+
+	var build func(i int) domain.Comment
+	build = func(i int) domain.Comment {
+		comment := comments[i]
+		comment.Replies = []domain.Comment{}
+		for _, child := range children[comment.ID] {
+			comment.Replies = append(comment.Replies, build(child))
+		}
+		return comment
+	}
+
+	tree := []domain.Comment{}
+	for _, i := range roots {
+		tree = append(tree, build(i))
+	}
+	return tree
+}
+
+func mentionIDs(users []domain.UserInfo) pq.StringArray {
+	ids := pq.StringArray{}
+	for _, user := range users {
+		ids = append(ids, user.ID.String())
+	}
+	return ids
+}
+
+func excerpt(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "..."
+}
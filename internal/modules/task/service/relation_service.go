@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/database"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type RelationService interface {
+	SetParent(ctx context.Context, taskID string, req *domain.SetParentRequest) (*domain.Task, error)
+
+	GetDependencies(ctx context.Context, taskID string) (*domain.TaskDependencies, error)
+	AddDependency(ctx context.Context, taskID string, req *domain.AddDependencyRequest) error
+	RemoveDependency(ctx context.Context, taskID string, blockerID string) error
+}
+
+type relationService struct {
+	relationRepo domain.TaskRelationRepository
+	taskRepo     domain.TaskRepository
+	activityRepo domain.ActivityRepository
+	uow          database.UnitOfWork
+	logger       logger.Logger
+}
+
+func NewRelationService(
+	relationRepo domain.TaskRelationRepository,
+	taskRepo domain.TaskRepository,
+	activityRepo domain.ActivityRepository,
+	uow database.UnitOfWork,
+	logger logger.Logger,
+) RelationService {
+	return &relationService{
+		relationRepo: relationRepo,
+		taskRepo:     taskRepo,
+		activityRepo: activityRepo,
+		uow:          uow,
+		logger:       logger,
+	}
+}
+
+// SetParent task'ı verilen task'ın alt görevi yapar. Yeni üst görev task'ın kendisi ya da
+// alt ağacındaki bir task ise hiyerarşide döngü oluşacağından istek reddedilir.
+func (s *relationService) SetParent(ctx context.Context, taskID string, req *domain.SetParentRequest) (*domain.Task, error) {
+	task, err := s.getTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	var newParentID *uuid.UUID
+	if req.ParentID != nil {
+		parent, err := s.taskRepo.GetByID(ctx, *req.ParentID)
+		if err != nil {
+			s.logger.Error("Failed to get parent task", err, map[string]interface{}{
+				"parent_id": *req.ParentID,
+			})
+			return nil, err
+		}
+		if parent == nil {
+			return nil, domain.ErrParentTaskNotFound{}
+		}
+		newParentID = &parent.ID
+	}
+
+	if sameUUID(task.ParentID, newParentID) {
+		return task, nil
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.relationRepo.LockGraph(ctx, tx); err != nil {
+			return err
+		}
+
+		if req.ParentID != nil {
+			cycle, err := s.relationRepo.IsDescendant(ctx, tx, taskID, *req.ParentID)
+			if err != nil {
+				return err
+			}
+			if cycle {
+				return domain.ErrTaskCycle{}
+			}
+		}
+
+		if err := s.relationRepo.SetParent(ctx, tx, taskID, req.ParentID); err != nil {
+			return err
+		}
+
+		return s.activityRepo.Create(ctx, tx, newActivity(task.ID, currentUserID(ctx), domain.ActivityParentChanged,
+			domain.FieldChanges("parent_id", task.ParentID, newParentID)))
+	})
+	if err != nil {
+		if _, ok := err.(domain.ErrTaskCycle); !ok {
+			s.logger.Error("Failed to set parent task", err, map[string]interface{}{
+				"task_id": taskID,
+			})
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Task parent changed", map[string]interface{}{
+		"action":    "TASK_PARENT_UPDATE",
+		"task_id":   taskID,
+		"parent_id": req.ParentID,
+	})
+
+	task.ParentID = newParentID
+	return task, nil
+}
+
+func (s *relationService) GetDependencies(ctx context.Context, taskID string) (*domain.TaskDependencies, error) {
+	if _, err := s.getTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+
+	dependencies, err := loadDependencies(ctx, s.relationRepo, taskID)
+	if err != nil {
+		s.logger.Error("Failed to get task dependencies", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return nil, err
+	}
+	return dependencies, nil
+}
+
+// AddDependency blocker task'ını taskID'nin engelleyicisi olarak ekler. taskID'den blocker'a
+// mevcut bağımlılıklar üzerinden zaten bir yol varsa yeni kenar döngü oluşturacağından reddedilir.
+func (s *relationService) AddDependency(ctx context.Context, taskID string, req *domain.AddDependencyRequest) error {
+	task, err := s.getTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	blocker, err := s.getTask(ctx, req.BlockerID)
+	if err != nil {
+		return err
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.relationRepo.LockGraph(ctx, tx); err != nil {
+			return err
+		}
+
+		cycle, err := s.relationRepo.HasPath(ctx, tx, taskID, req.BlockerID)
+		if err != nil {
+			return err
+		}
+		if cycle {
+			return domain.ErrTaskCycle{}
+		}
+
+		dependency := &domain.TaskDependency{
+			BlockerID: blocker.ID,
+			BlockedID: task.ID,
+			CreatedAt: time.Now(),
+		}
+		if err := s.relationRepo.CreateDependency(ctx, tx, dependency); err != nil {
+			return err
+		}
+
+		return s.activityRepo.Create(ctx, tx, newActivity(task.ID, currentUserID(ctx), domain.ActivityDependencyAdded, domain.ActivityMetadata(map[string]any{
+			"blocker_id":    blocker.ID,
+			"blocker_title": blocker.Title,
+		})))
+	})
+	if err != nil {
+		if _, ok := err.(domain.ErrTaskCycle); !ok {
+			s.logger.Error("Failed to add task dependency", err, map[string]interface{}{
+				"task_id":    taskID,
+				"blocker_id": req.BlockerID,
+			})
+		}
+		return err
+	}
+
+	s.logger.Info("Task dependency added", map[string]interface{}{
+		"action":     "TASK_DEPENDENCY_CREATE",
+		"task_id":    taskID,
+		"blocker_id": req.BlockerID,
+	})
+
+	return nil
+}
+
+func (s *relationService) RemoveDependency(ctx context.Context, taskID string, blockerID string) error {
+	task, err := s.getTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.relationRepo.DeleteDependency(ctx, tx, blockerID, taskID); err != nil {
+			return err
+		}
+		return s.activityRepo.Create(ctx, tx, newActivity(task.ID, currentUserID(ctx), domain.ActivityDependencyRemoved, domain.ActivityMetadata(map[string]any{
+			"blocker_id": blockerID,
+		})))
+	})
+	if err != nil {
+		if _, ok := err.(domain.ErrDependencyNotFound); !ok {
+			s.logger.Error("Failed to remove task dependency", err, map[string]interface{}{
+				"task_id":    taskID,
+				"blocker_id": blockerID,
+			})
+		}
+		return err
+	}
+
+	s.logger.Info("Task dependency removed", map[string]interface{}{
+		"action":     "TASK_DEPENDENCY_DELETE",
+		"task_id":    taskID,
+		"blocker_id": blockerID,
+	})
+
+	return nil
+}
+
+func (s *relationService) getTask(ctx context.Context, taskID string) (*domain.Task, error) {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		s.logger.Error("Failed to get task", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return nil, err
+	}
+	if task == nil {
+		return nil, domain.ErrTaskNotFound{}
+	}
+	return task, nil
+}
+
+func loadDependencies(ctx context.Context, relationRepo domain.TaskRelationRepository, taskID string) (*domain.TaskDependencies, error) {
+	blockedBy, err := relationRepo.GetBlockers(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := relationRepo.GetBlocking(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.TaskDependencies{BlockedBy: blockedBy, Blocks: blocks}, nil
+}
+
+// buildSubtaskTree düz alt görev listesini rootID altında iç içe bir ağaca çevirir.
+func buildSubtaskTree(rootID uuid.UUID, descendants []domain.Task) []domain.Task {
+	children := map[uuid.UUID][]int{}
+	for i := range descendants {
+		if descendants[i].ParentID != nil {
+			children[*descendants[i].ParentID] = append(children[*descendants[i].ParentID], i)
+		}
+	}
+
+	var build func(parentID uuid.UUID) []domain.Task
+	build = func(parentID uuid.UUID) []domain.Task {
+		subtasks := []domain.Task{}
+		for _, i := range children[parentID] {
+			subtask := descendants[i]
+			subtask.Subtasks = build(subtask.ID)
+			subtasks = append(subtasks, subtask)
+		}
+		return subtasks
+	}
+
+	return build(rootID)
+}
+
+func sameUUID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
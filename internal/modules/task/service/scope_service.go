@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/database"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type ScopeService interface {
+	ListScopes(ctx context.Context) ([]domain.Scope, error)
+	CreateScope(ctx context.Context, req *domain.CreateScopeRequest) (*domain.Scope, error)
+	UpdateScope(ctx context.Context, scopeID string, req *domain.UpdateScopeRequest) (*domain.Scope, error)
+	DeleteScope(ctx context.Context, scopeID string) error
+
+	AddScopeToAssignment(ctx context.Context, assignmentID string, req *domain.AddAssignmentScopeRequest) error
+	RemoveScopeFromAssignment(ctx context.Context, assignmentID string, scopeID string) error
+}
+
+type scopeService struct {
+	scopeRepo    domain.ScopeRepository
+	lookupRepo   domain.ScopeLookupRepository
+	assignRepo   domain.AssignmentRepository
+	activityRepo domain.ActivityRepository
+	uow          database.UnitOfWork
+	logger       logger.Logger
+}
+
+func NewScopeService(
+	scopeRepo domain.ScopeRepository,
+	lookupRepo domain.ScopeLookupRepository,
+	assignRepo domain.AssignmentRepository,
+	activityRepo domain.ActivityRepository,
+	uow database.UnitOfWork,
+	logger logger.Logger,
+) ScopeService {
+	return &scopeService{
+		scopeRepo:    scopeRepo,
+		lookupRepo:   lookupRepo,
+		assignRepo:   assignRepo,
+		activityRepo: activityRepo,
+		uow:          uow,
+		logger:       logger,
+	}
+}
+
+func (s *scopeService) ListScopes(ctx context.Context) ([]domain.Scope, error) {
+	scopes, err := s.lookupRepo.GetAll(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list scopes", err, nil)
+		return nil, err
+	}
+	return scopes, nil
+}
+
+func (s *scopeService) CreateScope(ctx context.Context, req *domain.CreateScopeRequest) (*domain.Scope, error) {
+	scope := &domain.Scope{
+		ID:          uuid.New(),
+		Name:        req.Name,
+		Permissions: req.Permissions,
+	}
+	if scope.Permissions == nil {
+		scope.Permissions = []string{}
+	}
+
+	if err := s.lookupRepo.Create(ctx, scope); err != nil {
+		s.logger.Error("Failed to create scope", err, map[string]interface{}{
+			"name": req.Name,
+		})
+		return nil, err
+	}
+
+	s.logger.Info("Scope created", map[string]interface{}{
+		"action":   "SCOPE_CREATE",
+		"actor":    utils.GetUsernameFromContext(ctx),
+		"scope_id": scope.ID.String(),
+		"name":     scope.Name,
+	})
+
+	return scope, nil
+}
+
+func (s *scopeService) UpdateScope(ctx context.Context, scopeID string, req *domain.UpdateScopeRequest) (*domain.Scope, error) {
+	scope, err := s.lookupRepo.GetByID(ctx, scopeID)
+	if err != nil {
+		s.logger.Error("Failed to get scope", err, map[string]interface{}{
+			"scope_id": scopeID,
+		})
+		return nil, err
+	}
+	if scope == nil {
+		return nil, domain.ErrScopeNotFound{}
+	}
+
+	if req.Name != nil {
+		scope.Name = *req.Name
+	}
+	if req.Permissions != nil {
+		scope.Permissions = req.Permissions
+	}
+
+	if err := s.lookupRepo.Update(ctx, scope); err != nil {
+		s.logger.Error("Failed to update scope", err, map[string]interface{}{
+			"scope_id": scopeID,
+		})
+		return nil, err
+	}
+
+	s.logger.Info("Scope updated", map[string]interface{}{
+		"action":   "SCOPE_UPDATE",
+		"actor":    utils.GetUsernameFromContext(ctx),
+		"scope_id": scopeID,
+	})
+
+	return scope, nil
+}
+
+func (s *scopeService) DeleteScope(ctx context.Context, scopeID string) error {
+	if err := s.lookupRepo.Delete(ctx, scopeID); err != nil {
+		s.logger.Error("Failed to delete scope", err, map[string]interface{}{
+			"scope_id": scopeID,
+		})
+		return err
+	}
+
+	s.logger.Info("Scope deleted", map[string]interface{}{
+		"action":   "SCOPE_DELETE",
+		"actor":    utils.GetUsernameFromContext(ctx),
+		"scope_id": scopeID,
+	})
+
+	return nil
+}
+
+func (s *scopeService) AddScopeToAssignment(ctx context.Context, assignmentID string, req *domain.AddAssignmentScopeRequest) error {
+	assignment, scope, err := s.lookup(ctx, assignmentID, req.ScopeID)
+	if err != nil {
+		return err
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.scopeRepo.AddToAssignment(ctx, tx, assignmentID, req.ScopeID); err != nil {
+			return err
+		}
+		return s.activityRepo.Create(ctx, tx, newActivity(assignment.TaskID, currentUserID(ctx), domain.ActivityScopeAdded, domain.ActivityMetadata(map[string]any{
+			"assignment_id": assignment.ID,
+			"scope":         scope.Name,
+		})))
+	})
+	if err != nil {
+		s.logger.Error("Failed to add scope to assignment", err, map[string]interface{}{
+			"assignment_id": assignmentID,
+			"scope_id":      req.ScopeID,
+		})
+		return err
+	}
+
+	s.logger.Info("Scope added to assignment", map[string]interface{}{
+		"action":        "ASSIGNMENT_SCOPE_CREATE",
+		"assignment_id": assignmentID,
+		"scope":         scope.Name,
+	})
+
+	return nil
+}
+
+func (s *scopeService) RemoveScopeFromAssignment(ctx context.Context, assignmentID string, scopeID string) error {
+	assignment, scope, err := s.lookup(ctx, assignmentID, scopeID)
+	if err != nil {
+		return err
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.scopeRepo.RemoveFromAssignment(ctx, tx, assignmentID, scopeID); err != nil {
+			return err
+		}
+		return s.activityRepo.Create(ctx, tx, newActivity(assignment.TaskID, currentUserID(ctx), domain.ActivityScopeRemoved, domain.ActivityMetadata(map[string]any{
+			"assignment_id": assignment.ID,
+			"scope":         scope.Name,
+		})))
+	})
+	if err != nil {
+		s.logger.Error("Failed to remove scope from assignment", err, map[string]interface{}{
+			"assignment_id": assignmentID,
+			"scope_id":      scopeID,
+		})
+		return err
+	}
+
+	s.logger.Info("Scope removed from assignment", map[string]interface{}{
+		"action":        "ASSIGNMENT_SCOPE_DELETE",
+		"assignment_id": assignmentID,
+		"scope":         scope.Name,
+	})
+
+	return nil
+}
+
+func (s *scopeService) lookup(ctx context.Context, assignmentID, scopeID string) (*domain.TaskAssignment, *domain.Scope, error) {
+	assignment, err := s.assignRepo.GetByID(ctx, assignmentID)
+	if err != nil {
+		s.logger.Error("Failed to get assignment", err, map[string]interface{}{
+			"assignment_id": assignmentID,
+		})
+		return nil, nil, err
+	}
+	if assignment == nil {
+		return nil, nil, domain.ErrAssignmentNotFound{}
+	}
+
+	scope, err := s.lookupRepo.GetByID(ctx, scopeID)
+	if err != nil {
+		s.logger.Error("Failed to get scope", err, map[string]interface{}{
+			"scope_id": scopeID,
+		})
+		return nil, nil, err
+	}
+	if scope == nil {
+		return nil, nil, domain.ErrScopeNotFound{}
+	}
+
+	return assignment, scope, nil
+}
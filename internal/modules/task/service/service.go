@@ -7,67 +7,123 @@ import (
 
 	"github.com/M1ralai/go-modular-monolith-template/internal/common/events"
 	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/database"
 	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
 	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/outbox"
 	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 )
 
 type TaskService interface {
 	CreateTask(ctx context.Context, req *domain.CreateTaskRequest) (*domain.Task, error)
-	GetTask(ctx context.Context, taskID string) (*domain.Task, error)
-	ListTasks(ctx context.Context) ([]domain.Task, error)
+	GetTask(ctx context.Context, taskID string, include domain.TaskInclude) (*domain.Task, error)
+	ListTasks(ctx context.Context, filter *domain.TaskFilter) (*domain.TaskPage, error)
+	UpdateTask(ctx context.Context, taskID string, req *domain.UpdateTaskRequest) (*domain.Task, error)
 	UpdateTaskStatus(ctx context.Context, taskID string, req *domain.UpdateStatusRequest) error
+	DeleteTask(ctx context.Context, taskID string) error
+	RestoreTask(ctx context.Context, taskID string) (*domain.Task, error)
 
-	AssignTask(ctx context.Context, taskID string, req *domain.AssignTaskRequest) (*domain.TaskAssignment, error)
+	AssignTask(ctx context.Context, taskID uuid.UUID, req *domain.AssignTaskRequest) (*domain.TaskAssignment, error)
 	UnassignTask(ctx context.Context, assignmentID string) error
 	GetTaskAssignments(ctx context.Context, taskID string) ([]domain.TaskAssignment, error)
+	ReassignOpenAssignments(ctx context.Context, fromUserID, toUserID uuid.UUID) (int, error)
 }
 
 type taskService struct {
 	taskRepo     domain.TaskRepository
+	workflowRepo domain.WorkflowRepository
+	relationRepo domain.TaskRelationRepository
 	assignRepo   domain.AssignmentRepository
+	scopeRepo    domain.ScopeRepository
 	activityRepo domain.ActivityRepository
 	userProvider domain.UserProvider
 	outboxRepo   outbox.Repository
+	uow          database.UnitOfWork
 	logger       logger.Logger
 }
 
 func NewTaskService(
 	taskRepo domain.TaskRepository,
+	workflowRepo domain.WorkflowRepository,
+	relationRepo domain.TaskRelationRepository,
 	assignRepo domain.AssignmentRepository,
+	scopeRepo domain.ScopeRepository,
 	activityRepo domain.ActivityRepository,
 	userProvider domain.UserProvider,
 	outboxRepo outbox.Repository,
+	uow database.UnitOfWork,
 	logger logger.Logger,
 ) TaskService {
 	return &taskService{
 		taskRepo:     taskRepo,
+		workflowRepo: workflowRepo,
+		relationRepo: relationRepo,
 		assignRepo:   assignRepo,
+		scopeRepo:    scopeRepo,
 		activityRepo: activityRepo,
 		userProvider: userProvider,
 		outboxRepo:   outboxRepo,
+		uow:          uow,
 		logger:       logger,
 	}
 }
 
 func (s *taskService) CreateTask(ctx context.Context, req *domain.CreateTaskRequest) (*domain.Task, error) {
-	userIDStr := utils.GetUserIDFromContext(ctx)
-	createdBy, _ := uuid.Parse(userIDStr)
-	if createdBy == uuid.Nil {
-		createdBy = uuid.New()
+	createdBy := currentUserID(ctx)
+
+	initialState, err := s.workflowRepo.GetInitialState(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get initial workflow state", err, nil)
+		return nil, err
+	}
+	if initialState == nil {
+		return nil, domain.ErrNoInitialState{}
+	}
+
+	priority := req.Priority
+	if priority == "" {
+		priority = domain.TaskPriorityMedium
+	}
+
+	var parentID *uuid.UUID
+	if req.ParentID != "" {
+		parent, err := s.taskRepo.GetByID(ctx, req.ParentID)
+		if err != nil {
+			s.logger.Error("Failed to get parent task", err, map[string]interface{}{
+				"parent_id": req.ParentID,
+			})
+			return nil, err
+		}
+		if parent == nil {
+			return nil, domain.ErrParentTaskNotFound{}
+		}
+		parentID = &parent.ID
 	}
 
 	task := &domain.Task{
-		ID:        uuid.New(),
-		Title:     req.Title,
-		Status:    domain.TaskStatusTodo,
-		CreatedBy: createdBy,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:              uuid.New(),
+		Title:           req.Title,
+		Description:     req.Description,
+		Status:          initialState.Key,
+		Priority:        priority,
+		DueDate:         req.DueDate,
+		EstimatedEffort: req.EstimatedEffort,
+		CreatedBy:       createdBy,
+		ParentID:        parentID,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
 	}
 
-	err := s.taskRepo.Create(ctx, task)
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.taskRepo.Create(ctx, tx, task); err != nil {
+			return err
+		}
+		return s.activityRepo.Create(ctx, tx, newActivity(task.ID, createdBy, domain.ActivityTaskCreated, domain.ActivityMetadata(map[string]any{
+			"title":  task.Title,
+			"status": task.Status,
+		})))
+	})
 	if err != nil {
 		s.logger.Error("Failed to create task", err, map[string]interface{}{
 			"title": req.Title,
@@ -75,15 +131,6 @@ func (s *taskService) CreateTask(ctx context.Context, req *domain.CreateTaskRequ
 		return nil, err
 	}
 
-	activity := &domain.Activity{
-		ID:        uuid.New(),
-		TaskID:    task.ID,
-		UserID:    createdBy,
-		Action:    domain.ActivityTaskCreated,
-		CreatedAt: time.Now(),
-	}
-	_ = s.activityRepo.Create(ctx, activity)
-
 	s.logger.Info("Task created", map[string]interface{}{
 		"action":  "TASK_CREATE",
 		"task_id": task.ID.String(),
@@ -93,7 +140,7 @@ func (s *taskService) CreateTask(ctx context.Context, req *domain.CreateTaskRequ
 	return task, nil
 }
 
-func (s *taskService) GetTask(ctx context.Context, taskID string) (*domain.Task, error) {
+func (s *taskService) GetTask(ctx context.Context, taskID string, include domain.TaskInclude) (*domain.Task, error) {
 	task, err := s.taskRepo.GetByID(ctx, taskID)
 	if err != nil {
 		s.logger.Error("Failed to get task", err, map[string]interface{}{
@@ -101,90 +148,499 @@ func (s *taskService) GetTask(ctx context.Context, taskID string) (*domain.Task,
 		})
 		return nil, err
 	}
+	if task == nil {
+		return nil, nil
+	}
+
+	if include.Subtasks {
+		descendants, err := s.relationRepo.GetDescendants(ctx, taskID)
+		if err != nil {
+			s.logger.Error("Failed to get subtasks", err, map[string]interface{}{
+				"task_id": taskID,
+			})
+			return nil, err
+		}
+		task.Subtasks = buildSubtaskTree(task.ID, descendants)
+	}
+
+	if include.Dependencies {
+		dependencies, err := loadDependencies(ctx, s.relationRepo, taskID)
+		if err != nil {
+			s.logger.Error("Failed to get task dependencies", err, map[string]interface{}{
+				"task_id": taskID,
+			})
+			return nil, err
+		}
+		task.Dependencies = dependencies
+	}
+
 	return task, nil
 }
 
-func (s *taskService) ListTasks(ctx context.Context) ([]domain.Task, error) {
-	tasks, err := s.taskRepo.List(ctx)
+func (s *taskService) ListTasks(ctx context.Context, filter *domain.TaskFilter) (*domain.TaskPage, error) {
+	filter.Normalize()
+
+	if filter.Cursor != "" {
+		cursor, err := domain.DecodeTaskCursor(filter.Cursor)
+		if err != nil || cursor.Sort != filter.Sort || cursor.Order != filter.Order {
+			return nil, domain.ErrInvalidCursor{}
+		}
+		filter.After = cursor
+	}
+
+	pageSize := filter.Limit
+	filter.Limit = pageSize + 1
+	tasks, err := s.taskRepo.List(ctx, filter)
+	filter.Limit = pageSize
 	if err != nil {
 		s.logger.Error("Failed to list tasks", err, nil)
 		return nil, err
 	}
 
+	page := &domain.TaskPage{Items: tasks}
+	if len(tasks) > pageSize {
+		page.Items = tasks[:pageSize]
+		page.NextCursor = domain.NewTaskCursor(&page.Items[pageSize-1], filter.Sort, filter.Order).Encode()
+	}
+
+	if filter.IncludeTotal {
+		total, err := s.taskRepo.Count(ctx, filter)
+		if err != nil {
+			s.logger.Error("Failed to count tasks", err, nil)
+			return nil, err
+		}
+		page.Total = &total
+	}
+
 	s.logger.Info("Tasks listed", map[string]interface{}{
 		"action": "TASK_LIST",
-		"count":  len(tasks),
+		"count":  len(page.Items),
 	})
 
-	return tasks, nil
+	return page, nil
+}
+
+func (s *taskService) UpdateTask(ctx context.Context, taskID string, req *domain.UpdateTaskRequest) (*domain.Task, error) {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		s.logger.Error("Failed to get task", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return nil, err
+	}
+	if task == nil {
+		return nil, domain.ErrTaskNotFound{}
+	}
+	if err := s.checkScope(ctx, task, domain.ScopePermissionTaskEdit); err != nil {
+		return nil, err
+	}
+
+	userID := currentUserID(ctx)
+	var activities []*domain.Activity
+	var actions []domain.ActivityAction
+	record := func(action domain.ActivityAction, field string, from, to any) {
+		activities = append(activities, newActivity(task.ID, userID, action, domain.FieldChanges(field, from, to)))
+		actions = append(actions, action)
+	}
+
+	if req.Title != nil && *req.Title != task.Title {
+		record(domain.ActivityTaskTitleChanged, "title", task.Title, *req.Title)
+		task.Title = *req.Title
+	}
+	if req.Description != nil && *req.Description != task.Description {
+		record(domain.ActivityTaskDescriptionChanged, "description", task.Description, *req.Description)
+		task.Description = *req.Description
+	}
+	if req.Priority != nil && *req.Priority != task.Priority {
+		record(domain.ActivityTaskPriorityChanged, "priority", task.Priority, *req.Priority)
+		task.Priority = *req.Priority
+	}
+	if req.DueDate.Set && !sameTime(req.DueDate.Value, task.DueDate) {
+		record(domain.ActivityTaskDueDateChanged, "due_date", task.DueDate, req.DueDate.Value)
+		task.DueDate = req.DueDate.Value
+	}
+	if req.EstimatedEffort.Set && !sameInt(req.EstimatedEffort.Value, task.EstimatedEffort) {
+		record(domain.ActivityTaskEffortChanged, "estimated_effort", task.EstimatedEffort, req.EstimatedEffort.Value)
+		task.EstimatedEffort = req.EstimatedEffort.Value
+	}
+
+	if len(activities) == 0 {
+		return task, nil
+	}
+
+	task.UpdatedAt = time.Now()
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.taskRepo.Update(ctx, tx, task); err != nil {
+			return err
+		}
+		for _, activity := range activities {
+			if err := s.activityRepo.Create(ctx, tx, activity); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to update task", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return nil, err
+	}
+
+	s.logger.Info("Task updated", map[string]interface{}{
+		"action":  "TASK_UPDATE",
+		"task_id": taskID,
+		"changes": actions,
+	})
+
+	return task, nil
+}
+
+func (s *taskService) DeleteTask(ctx context.Context, taskID string) error {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		s.logger.Error("Failed to get task", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return err
+	}
+	if task == nil {
+		return domain.ErrTaskNotFound{}
+	}
+	if err := s.checkScope(ctx, task, domain.ScopePermissionTaskEdit); err != nil {
+		return err
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.taskRepo.SoftDelete(ctx, tx, taskID); err != nil {
+			return err
+		}
+		return s.activityRepo.Create(ctx, tx, newActivity(task.ID, currentUserID(ctx), domain.ActivityTaskDeleted, domain.ActivityMetadata(map[string]any{
+			"title": task.Title,
+		})))
+	})
+	if err != nil {
+		s.logger.Error("Failed to delete task", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return err
+	}
+
+	s.logger.Info("Task deleted", map[string]interface{}{
+		"action":  "TASK_DELETE",
+		"task_id": taskID,
+	})
+
+	return nil
+}
+
+func (s *taskService) RestoreTask(ctx context.Context, taskID string) (*domain.Task, error) {
+	task, err := s.taskRepo.GetDeletedByID(ctx, taskID)
+	if err != nil {
+		s.logger.Error("Failed to get deleted task", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return nil, err
+	}
+	if task == nil {
+		return nil, domain.ErrTaskNotFound{}
+	}
+	if err := s.checkScope(ctx, task, domain.ScopePermissionTaskEdit); err != nil {
+		return nil, err
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.taskRepo.Restore(ctx, tx, taskID); err != nil {
+			return err
+		}
+		return s.activityRepo.Create(ctx, tx, newActivity(task.ID, currentUserID(ctx), domain.ActivityTaskRestored, domain.ActivityChanges{}))
+	})
+	if err != nil {
+		if _, ok := err.(domain.ErrTaskNotFound); !ok {
+			s.logger.Error("Failed to restore task", err, map[string]interface{}{
+				"task_id": taskID,
+			})
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Task restored", map[string]interface{}{
+		"action":  "TASK_RESTORE",
+		"actor":   utils.GetUsernameFromContext(ctx),
+		"task_id": taskID,
+	})
+
+	return s.taskRepo.GetByID(ctx, taskID)
 }
 
 func (s *taskService) UpdateTaskStatus(ctx context.Context, taskID string, req *domain.UpdateStatusRequest) error {
-	err := s.taskRepo.UpdateStatus(ctx, taskID, req.Status)
+	task, err := s.taskRepo.GetByID(ctx, taskID)
 	if err != nil {
-		s.logger.Error("Failed to update task status", err, map[string]interface{}{
+		s.logger.Error("Failed to get task", err, map[string]interface{}{
 			"task_id": taskID,
-			"status":  req.Status,
 		})
 		return err
 	}
+	if task == nil {
+		return domain.ErrTaskNotFound{}
+	}
+	if task.Status == req.Status {
+		return nil
+	}
+	if err := s.checkScope(ctx, task, domain.ScopePermissionStatusUpdate); err != nil {
+		return err
+	}
 
-	userIDStr := utils.GetUserIDFromContext(ctx)
-	userID, _ := uuid.Parse(userIDStr)
-	if userID == uuid.Nil {
-		userID = uuid.New()
+	state, err := s.checkTransition(ctx, task.Status, req.Status)
+	if err == nil && state.IsFinal {
+		err = s.checkCompletable(ctx, taskID)
 	}
-	activity := &domain.Activity{
-		ID:        uuid.New(),
-		TaskID:    uuid.MustParse(taskID),
-		UserID:    userID,
-		Action:    domain.ActivityTaskStatusChanged,
-		CreatedAt: time.Now(),
+	if err != nil {
+		s.logger.Info("Task status transition rejected", map[string]interface{}{
+			"action":  "TASK_STATUS_TRANSITION_REJECTED",
+			"task_id": taskID,
+			"from":    task.Status,
+			"to":      req.Status,
+			"reason":  err.Error(),
+		})
+		return err
+	}
+
+	changedAt := time.Now()
+	actorID := currentUserID(ctx)
+	actorName := utils.GetUsernameFromContext(ctx)
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.taskRepo.UpdateStatus(ctx, tx, taskID, task.Status, req.Status); err != nil {
+			s.logger.Error("Failed to update task status", err, map[string]interface{}{
+				"task_id": taskID,
+				"status":  req.Status,
+			})
+			return err
+		}
+
+		activity := newActivity(task.ID, actorID, domain.ActivityTaskStatusChanged,
+			domain.FieldChanges("status", task.Status, req.Status))
+		if err := s.activityRepo.Create(ctx, tx, activity); err != nil {
+			s.logger.Error("Failed to create activity", err, map[string]interface{}{
+				"task_id": taskID,
+			})
+			return err
+		}
+
+		statusEvent := events.TaskStatusChangedEvent{
+			TaskID:    taskID,
+			TaskTitle: task.Title,
+			OldStatus: string(task.Status),
+			NewStatus: string(req.Status),
+			ActorID:   actorID.String(),
+			ActorName: actorName,
+			ChangedAt: changedAt,
+		}
+		if err := s.writeOutbox(ctx, tx, task.ID, events.TopicTaskStatusChanged, statusEvent); err != nil {
+			return err
+		}
+
+		if state.IsFinal {
+			doneEvent := events.TaskDoneEvent{
+				TaskID:          taskID,
+				TaskTitle:       task.Title,
+				CompletedBy:     actorID.String(),
+				CompletedByName: actorName,
+				CompletedAt:     changedAt,
+				Recipients:      s.taskRecipients(ctx, task),
+			}
+			if err := s.writeOutbox(ctx, tx, task.ID, events.TopicTaskDone, doneEvent); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
-	_ = s.activityRepo.Create(ctx, activity)
 
 	s.logger.Info("Task status updated", map[string]interface{}{
 		"action":     "TASK_STATUS_UPDATE",
 		"task_id":    taskID,
+		"old_status": task.Status,
 		"new_status": req.Status,
 	})
 
 	return nil
 }
 
-func (s *taskService) AssignTask(ctx context.Context, taskID string, req *domain.AssignTaskRequest) (*domain.TaskAssignment, error) {
-	tx, err := s.assignRepo.BeginTx(ctx)
+// writeOutbox event'i aynı transaction içinde outbox tablosuna yazar.
+func (s *taskService) writeOutbox(ctx context.Context, tx *sqlx.Tx, taskID uuid.UUID, topic string, event any) error {
+	return writeTaskOutbox(ctx, s.outboxRepo, s.logger, tx, taskID, topic, event)
+}
+
+func writeTaskOutbox(ctx context.Context, outboxRepo outbox.Repository, log logger.Logger, tx *sqlx.Tx, taskID uuid.UUID, topic string, event any) error {
+	payload, err := json.Marshal(event)
 	if err != nil {
-		s.logger.Error("Failed to begin transaction", err, nil)
-		return nil, err
+		log.Error("Failed to marshal event", err, map[string]interface{}{
+			"event_type": topic,
+		})
+		return err
 	}
-	defer tx.Rollback()
 
-	assignment := &domain.TaskAssignment{
-		ID:        uuid.New(),
-		TaskID:    uuid.MustParse(taskID),
-		UserID:    uuid.MustParse(req.UserID),
-		CreatedAt: time.Now(),
+	outboxEvent := &outbox.OutboxEvent{
+		AggregateType: "task",
+		AggregateID:   taskID,
+		EventType:     topic,
+		Payload:       payload,
 	}
 
-	if err := s.assignRepo.Create(ctx, tx, assignment); err != nil {
-		s.logger.Error("Failed to assign task", err, map[string]interface{}{
-			"task_id": taskID,
-			"user_id": req.UserID,
+	if err := outboxRepo.Create(ctx, tx, outboxEvent); err != nil {
+		log.Error("Failed to create outbox event", err, map[string]interface{}{
+			"task_id":    taskID.String(),
+			"event_type": topic,
 		})
+		return err
+	}
+	return nil
+}
+
+// taskRecipients task'ı oluşturan ve task'a atanmış kullanıcıları tekilleştirerek döner.
+// Bilgisi alınamayan kullanıcılar atlanır; bildirim eksikliği status değişikliğini engellemez.
+func (s *taskService) taskRecipients(ctx context.Context, task *domain.Task) []events.Recipient {
+	userIDs := []uuid.UUID{task.CreatedBy}
+
+	assignments, err := s.assignRepo.GetByTask(ctx, task.ID.String())
+	if err != nil {
+		s.logger.Error("Failed to get task assignments for event", err, map[string]interface{}{
+			"task_id": task.ID.String(),
+		})
+	}
+	for _, assignment := range assignments {
+		userIDs = append(userIDs, assignment.UserID)
+	}
+
+	seen := map[uuid.UUID]bool{}
+	recipients := []events.Recipient{}
+	for _, userID := range userIDs {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+
+		userInfo, err := s.userProvider.GetUserByID(ctx, userID)
+		if err != nil {
+			s.logger.Error("Failed to get user info for event", err, map[string]interface{}{
+				"user_id": userID.String(),
+			})
+			continue
+		}
+		recipients = append(recipients, events.Recipient{
+			UserID:    userInfo.ID.String(),
+			UserName:  userInfo.Username,
+			UserEmail: userInfo.Email,
+		})
+	}
+	return recipients
+}
+
+// checkScope kullanıcının task üzerindeki yazma işlemine atamaları üzerinden yetkili olduğunu
+// doğrular. ADMIN'ler ve task'ı oluşturan kullanıcı kısıtlanmaz; task'a atanmamış kullanıcılar
+// reddedilir. Scope'suz bir atama tam yetki sayılır, scope'lu atamalarda scope'lardan biri
+// istenen izni içermelidir.
+func (s *taskService) checkScope(ctx context.Context, task *domain.Task, permission string) error {
+	if utils.GetRoleFromContext(ctx) == "ADMIN" {
+		return nil
+	}
+
+	userID := utils.GetUserIDFromContext(ctx)
+	if userID == task.CreatedBy.String() {
+		return nil
+	}
+
+	assignments, err := s.assignRepo.GetByTask(ctx, task.ID.String())
+	if err != nil {
+		return err
+	}
+
+	ids := []string{}
+	for _, assignment := range assignments {
+		if assignment.UserID.String() == userID {
+			ids = append(ids, assignment.ID.String())
+		}
+	}
+	if len(ids) == 0 {
+		return domain.ErrScopeDenied{Permission: permission}
+	}
+
+	scopes, err := s.scopeRepo.GetByAssignments(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if len(scopes[id]) == 0 {
+			return nil
+		}
+		for _, scope := range scopes[id] {
+			if scope.Allows(permission) {
+				return nil
+			}
+		}
+	}
+
+	return domain.ErrScopeDenied{Permission: permission}
+}
+
+// checkTransition hedef durumun workflow'da tanımlı olduğunu ve mevcut durumdan
+// hedefe geçişin, gerekiyorsa kullanıcının rolüyle birlikte, izinli olduğunu doğrular.
+func (s *taskService) checkTransition(ctx context.Context, from, to domain.TaskStatus) (*domain.WorkflowState, error) {
+	state, err := s.workflowRepo.GetState(ctx, to)
+	if err != nil {
 		return nil, err
 	}
+	if state == nil {
+		return nil, domain.ErrUnknownStatus{Status: to}
+	}
+
+	transition, err := s.workflowRepo.GetTransition(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if transition == nil {
+		return nil, domain.ErrTransitionNotAllowed{From: from, To: to}
+	}
+
+	if transition.RequiredRole != nil && *transition.RequiredRole != utils.GetRoleFromContext(ctx) {
+		return nil, domain.ErrTransitionRoleRequired{From: from, To: to, Role: *transition.RequiredRole}
+	}
+
+	return state, nil
+}
+
+// checkCompletable task'ın final bir duruma (ör. done) geçebilmesi için açık engelleyicisi
+// ve bitmemiş alt görevi olmadığını doğrular.
+func (s *taskService) checkCompletable(ctx context.Context, taskID string) error {
+	openBlockers, err := s.relationRepo.CountOpenBlockers(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	openSubtasks, err := s.relationRepo.CountOpenSubtasks(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	if openBlockers > 0 || openSubtasks > 0 {
+		return domain.ErrTaskBlocked{OpenBlockers: openBlockers, OpenSubtasks: openSubtasks}
+	}
+	return nil
+}
 
-	activity := &domain.Activity{
+func (s *taskService) AssignTask(ctx context.Context, taskID uuid.UUID, req *domain.AssignTaskRequest) (*domain.TaskAssignment, error) {
+	assignment := &domain.TaskAssignment{
 		ID:        uuid.New(),
-		TaskID:    assignment.TaskID,
-		UserID:    assignment.UserID,
-		Action:    domain.ActivityAssignmentAdded,
+		TaskID:    taskID,
+		UserID:    uuid.MustParse(req.UserID),
 		CreatedAt: time.Now(),
 	}
-	_ = s.activityRepo.Create(ctx, activity)
 
-	userInfo, err := s.userProvider.GetUserByID(assignment.UserID)
+	userInfo, err := s.userProvider.GetUserByID(ctx, assignment.UserID)
 	if err != nil {
 		s.logger.Error("Failed to get user info for event", err, map[string]interface{}{
 			"user_id": req.UserID,
@@ -196,54 +652,57 @@ func (s *taskService) AssignTask(ctx context.Context, taskID string, req *domain
 		}
 	}
 
-	task, err := s.taskRepo.GetByID(ctx, taskID)
+	task, err := s.taskRepo.GetByID(ctx, taskID.String())
 	if err != nil {
 		s.logger.Error("Failed to get task info for event", err, map[string]interface{}{
-			"task_id": taskID,
+			"task_id": taskID.String(),
 		})
 		task = &domain.Task{
 			ID:    assignment.TaskID,
 			Title: "Unknown Task",
 		}
 	}
+	if task == nil {
+		return nil, domain.ErrTaskNotFound{}
+	}
 
 	event := events.TaskAssignedEvent{
-		TaskID:    taskID,
+		TaskID:    taskID.String(),
 		TaskTitle: task.Title,
 		UserID:    req.UserID,
 		UserEmail: userInfo.Email,
 		UserName:  userInfo.Username,
 	}
 
-	eventPayload, err := json.Marshal(event)
-	if err != nil {
-		s.logger.Error("Failed to marshal event", err, nil)
-		return nil, err
-	}
-
-	outboxEvent := &outbox.OutboxEvent{
-		AggregateType: "task",
-		AggregateID:   assignment.TaskID,
-		EventType:     events.TopicTaskAssigned,
-		Payload:       eventPayload,
-	}
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.assignRepo.Create(ctx, tx, assignment); err != nil {
+			s.logger.Error("Failed to assign task", err, map[string]interface{}{
+				"task_id": taskID.String(),
+				"user_id": req.UserID,
+			})
+			return err
+		}
 
-	if err := s.outboxRepo.Create(ctx, tx, outboxEvent); err != nil {
-		s.logger.Error("Failed to create outbox event", err, map[string]interface{}{
-			"task_id": taskID,
-			"user_id": req.UserID,
-		})
-		return nil, err
-	}
+		activity := newActivity(assignment.TaskID, assignment.UserID, domain.ActivityAssignmentAdded, domain.ActivityMetadata(map[string]any{
+			"assignment_id": assignment.ID,
+			"assignee_id":   assignment.UserID,
+		}))
+		if err := s.activityRepo.Create(ctx, tx, activity); err != nil {
+			s.logger.Error("Failed to create activity", err, map[string]interface{}{
+				"task_id": taskID.String(),
+			})
+			return err
+		}
 
-	if err := tx.Commit(); err != nil {
-		s.logger.Error("Failed to commit transaction", err, nil)
+		return s.writeOutbox(ctx, tx, assignment.TaskID, events.TopicTaskAssigned, event)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	s.logger.Info("Task assigned", map[string]interface{}{
 		"action":     "TASK_ASSIGN",
-		"task_id":    taskID,
+		"task_id":    taskID.String(),
 		"user_id":    req.UserID,
 		"user_email": userInfo.Email,
 	})
@@ -252,22 +711,136 @@ func (s *taskService) AssignTask(ctx context.Context, taskID string, req *domain
 }
 
 func (s *taskService) UnassignTask(ctx context.Context, assignmentID string) error {
-	err := s.assignRepo.Delete(ctx, assignmentID)
+	assignment, err := s.assignRepo.GetByID(ctx, assignmentID)
 	if err != nil {
-		s.logger.Error("Failed to unassign task", err, map[string]interface{}{
+		s.logger.Error("Failed to get assignment", err, map[string]interface{}{
 			"assignment_id": assignmentID,
 		})
 		return err
 	}
+	if assignment == nil {
+		return domain.ErrAssignmentNotFound{}
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.assignRepo.Delete(ctx, tx, assignmentID); err != nil {
+			return err
+		}
+		return s.activityRepo.Create(ctx, tx, newActivity(assignment.TaskID, currentUserID(ctx), domain.ActivityAssignmentRemoved, domain.ActivityMetadata(map[string]any{
+			"assignment_id": assignment.ID,
+			"assignee_id":   assignment.UserID,
+		})))
+	})
+	if err != nil {
+		if _, ok := err.(domain.ErrAssignmentNotFound); !ok {
+			s.logger.Error("Failed to unassign task", err, map[string]interface{}{
+				"assignment_id": assignmentID,
+			})
+		}
+		return err
+	}
 
 	s.logger.Info("Task unassigned", map[string]interface{}{
 		"action":        "TASK_UNASSIGN",
+		"actor":         utils.GetUsernameFromContext(ctx),
 		"assignment_id": assignmentID,
+		"task_id":       assignment.TaskID.String(),
+		"assignee_id":   assignment.UserID.String(),
 	})
 
 	return nil
 }
 
+// ReassignOpenAssignments kullanıcının final olmayan task'lardaki atamalarını toUserID'ye
+// devreder. Hedef kullanıcı task'a zaten atanmışsa eski atama kaldırılır. Kullanıcı
+// devre dışı bırakılırken kullanılır; devredilen her task için aktivite kaydı ve yeni
+// atanana bildirim event'i yazılır.
+func (s *taskService) ReassignOpenAssignments(ctx context.Context, fromUserID, toUserID uuid.UUID) (int, error) {
+	target, err := s.userProvider.GetUserByID(ctx, toUserID)
+	if err != nil {
+		s.logger.Error("Failed to get user info for event", err, map[string]interface{}{
+			"user_id": toUserID.String(),
+		})
+		return 0, err
+	}
+
+	actorID := currentUserID(ctx)
+	reassigned := 0
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		assignments, err := s.assignRepo.ListOpenByUser(ctx, tx, fromUserID)
+		if err != nil {
+			return err
+		}
+
+		handled := make(map[uuid.UUID]bool, len(assignments))
+		for _, assignment := range assignments {
+			alreadyAssigned := handled[assignment.TaskID]
+			if !alreadyAssigned {
+				current, err := s.assignRepo.GetByTaskTx(ctx, tx, assignment.TaskID.String())
+				if err != nil {
+					return err
+				}
+				for _, a := range current {
+					if a.UserID == toUserID {
+						alreadyAssigned = true
+						break
+					}
+				}
+			}
+
+			if alreadyAssigned {
+				err = s.assignRepo.Delete(ctx, tx, assignment.ID.String())
+			} else {
+				err = s.assignRepo.Reassign(ctx, tx, assignment.ID, toUserID)
+			}
+			if err != nil {
+				return err
+			}
+			handled[assignment.TaskID] = true
+
+			activity := newActivity(assignment.TaskID, actorID, domain.ActivityAssignmentReassigned, domain.ActivityMetadata(map[string]any{
+				"assignment_id": assignment.ID,
+				"from_user_id":  fromUserID,
+				"to_user_id":    toUserID,
+			}))
+			if err := s.activityRepo.Create(ctx, tx, activity); err != nil {
+				return err
+			}
+
+			if !alreadyAssigned {
+				event := events.TaskAssignedEvent{
+					TaskID:    assignment.TaskID.String(),
+					TaskTitle: assignment.TaskTitle,
+					UserID:    toUserID.String(),
+					UserEmail: target.Email,
+					UserName:  target.Username,
+				}
+				if err := s.writeOutbox(ctx, tx, assignment.TaskID, events.TopicTaskAssigned, event); err != nil {
+					return err
+				}
+			}
+			reassigned++
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to reassign assignments", err, map[string]interface{}{
+			"from_user_id": fromUserID.String(),
+			"to_user_id":   toUserID.String(),
+		})
+		return 0, err
+	}
+
+	s.logger.Info("Open assignments reassigned", map[string]interface{}{
+		"action":       "TASK_REASSIGN",
+		"from_user_id": fromUserID.String(),
+		"to_user_id":   toUserID.String(),
+		"count":        reassigned,
+	})
+
+	return reassigned, nil
+}
+
 func (s *taskService) GetTaskAssignments(ctx context.Context, taskID string) ([]domain.TaskAssignment, error) {
 	assignments, err := s.assignRepo.GetByTask(ctx, taskID)
 	if err != nil {
@@ -276,5 +849,57 @@ func (s *taskService) GetTaskAssignments(ctx context.Context, taskID string) ([]
 		})
 		return nil, err
 	}
+
+	ids := make([]string, len(assignments))
+	for i, assignment := range assignments {
+		ids[i] = assignment.ID.String()
+	}
+
+	scopes, err := s.scopeRepo.GetByAssignments(ctx, ids)
+	if err != nil {
+		s.logger.Error("Failed to get assignment scopes", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return nil, err
+	}
+
+	for i := range assignments {
+		assignments[i].Scopes = scopes[assignments[i].ID.String()]
+		if assignments[i].Scopes == nil {
+			assignments[i].Scopes = []domain.Scope{}
+		}
+	}
+
 	return assignments, nil
 }
+
+// currentUserID AuthMiddleware'in doğruladığı token'daki kullanıcıyı döner.
+func currentUserID(ctx context.Context) uuid.UUID {
+	userID, _ := uuid.Parse(utils.GetUserIDFromContext(ctx))
+	return userID
+}
+
+func newActivity(taskID, userID uuid.UUID, action domain.ActivityAction, changes domain.ActivityChanges) *domain.Activity {
+	return &domain.Activity{
+		ID:        uuid.New(),
+		TaskID:    taskID,
+		UserID:    userID,
+		Action:    action,
+		Changes:   changes,
+		CreatedAt: time.Now(),
+	}
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func sameInt(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
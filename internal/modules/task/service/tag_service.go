@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/database"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type TagService interface {
+	ListTags(ctx context.Context) ([]domain.Tag, error)
+	CreateTag(ctx context.Context, req *domain.CreateTagRequest) (*domain.Tag, error)
+	RenameTag(ctx context.Context, tagID string, req *domain.RenameTagRequest) (*domain.Tag, error)
+	DeleteTag(ctx context.Context, tagID string) error
+	MergeTag(ctx context.Context, sourceID string, req *domain.MergeTagRequest) (*domain.Tag, error)
+
+	GetTaskTags(ctx context.Context, taskID string) ([]domain.Tag, error)
+	AddTagToTask(ctx context.Context, taskID string, req *domain.AddTaskTagRequest) error
+	RemoveTagFromTask(ctx context.Context, taskID string, tagID string) error
+}
+
+type tagService struct {
+	tagRepo      domain.TagRepository
+	taskRepo     domain.TaskRepository
+	activityRepo domain.ActivityRepository
+	uow          database.UnitOfWork
+	logger       logger.Logger
+}
+
+func NewTagService(
+	tagRepo domain.TagRepository,
+	taskRepo domain.TaskRepository,
+	activityRepo domain.ActivityRepository,
+	uow database.UnitOfWork,
+	logger logger.Logger,
+) TagService {
+	return &tagService{
+		tagRepo:      tagRepo,
+		taskRepo:     taskRepo,
+		activityRepo: activityRepo,
+		uow:          uow,
+		logger:       logger,
+	}
+}
+
+func (s *tagService) ListTags(ctx context.Context) ([]domain.Tag, error) {
+	tags, err := s.tagRepo.GetAll(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list tags", err, nil)
+		return nil, err
+	}
+	return tags, nil
+}
+
+func (s *tagService) CreateTag(ctx context.Context, req *domain.CreateTagRequest) (*domain.Tag, error) {
+	tag := &domain.Tag{
+		ID:   uuid.New(),
+		Name: strings.TrimSpace(req.Name),
+	}
+
+	if err := s.tagRepo.Create(ctx, tag); err != nil {
+		s.logger.Error("Failed to create tag", err, map[string]interface{}{
+			"name": req.Name,
+		})
+		return nil, err
+	}
+
+	s.logger.Info("Tag created", map[string]interface{}{
+		"action": "TAG_CREATE",
+		"actor":  utils.GetUsernameFromContext(ctx),
+		"tag_id": tag.ID.String(),
+		"name":   tag.Name,
+	})
+
+	return tag, nil
+}
+
+func (s *tagService) RenameTag(ctx context.Context, tagID string, req *domain.RenameTagRequest) (*domain.Tag, error) {
+	name := strings.TrimSpace(req.Name)
+	if err := s.tagRepo.Rename(ctx, tagID, name); err != nil {
+		s.logger.Error("Failed to rename tag", err, map[string]interface{}{
+			"tag_id": tagID,
+			"name":   name,
+		})
+		return nil, err
+	}
+
+	s.logger.Info("Tag renamed", map[string]interface{}{
+		"action": "TAG_UPDATE",
+		"actor":  utils.GetUsernameFromContext(ctx),
+		"tag_id": tagID,
+		"name":   name,
+	})
+
+	return s.tagRepo.GetByID(ctx, tagID)
+}
+
+func (s *tagService) DeleteTag(ctx context.Context, tagID string) error {
+	if err := s.tagRepo.Delete(ctx, tagID); err != nil {
+		s.logger.Error("Failed to delete tag", err, map[string]interface{}{
+			"tag_id": tagID,
+		})
+		return err
+	}
+
+	s.logger.Info("Tag deleted", map[string]interface{}{
+		"action": "TAG_DELETE",
+		"actor":  utils.GetUsernameFromContext(ctx),
+		"tag_id": tagID,
+	})
+
+	return nil
+}
+
+func (s *tagService) MergeTag(ctx context.Context, sourceID string, req *domain.MergeTagRequest) (*domain.Tag, error) {
+	if sourceID == req.TargetID {
+		return nil, domain.ErrTagMergeSelf{}
+	}
+
+	var source, target *domain.Tag
+	for _, tagID := range []string{sourceID, req.TargetID} {
+		tag, err := s.tagRepo.GetByID(ctx, tagID)
+		if err != nil {
+			s.logger.Error("Failed to get tag", err, map[string]interface{}{
+				"tag_id": tagID,
+			})
+			return nil, err
+		}
+		if tag == nil {
+			return nil, domain.ErrTagNotFound{}
+		}
+		if tagID == sourceID {
+			source = tag
+		} else {
+			target = tag
+		}
+	}
+
+	var taskIDs []uuid.UUID
+	actorID := currentUserID(ctx)
+	err := s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		taskIDs, err = s.tagRepo.Merge(ctx, tx, sourceID, req.TargetID)
+		if err != nil {
+			return err
+		}
+		for _, taskID := range taskIDs {
+			activity := newActivity(taskID, actorID, domain.ActivityTagMerged, domain.ActivityMetadata(map[string]any{
+				"from_tag": source.Name,
+				"to_tag":   target.Name,
+			}))
+			if err := s.activityRepo.Create(ctx, tx, activity); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to merge tags", err, map[string]interface{}{
+			"source_id": sourceID,
+			"target_id": req.TargetID,
+		})
+		return nil, err
+	}
+
+	s.logger.Info("Tags merged", map[string]interface{}{
+		"action":     "TAG_MERGE",
+		"actor":      utils.GetUsernameFromContext(ctx),
+		"source_id":  sourceID,
+		"target_id":  req.TargetID,
+		"task_count": len(taskIDs),
+	})
+
+	return s.tagRepo.GetByID(ctx, req.TargetID)
+}
+
+func (s *tagService) GetTaskTags(ctx context.Context, taskID string) ([]domain.Tag, error) {
+	tags, err := s.tagRepo.GetByTask(ctx, taskID)
+	if err != nil {
+		s.logger.Error("Failed to get task tags", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return nil, err
+	}
+	return tags, nil
+}
+
+func (s *tagService) AddTagToTask(ctx context.Context, taskID string, req *domain.AddTaskTagRequest) error {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		s.logger.Error("Failed to get task", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return err
+	}
+	if task == nil {
+		return domain.ErrTaskNotFound{}
+	}
+
+	tag, err := s.tagRepo.GetByID(ctx, req.TagID)
+	if err != nil {
+		s.logger.Error("Failed to get tag", err, map[string]interface{}{
+			"tag_id": req.TagID,
+		})
+		return err
+	}
+	if tag == nil {
+		return domain.ErrTagNotFound{}
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.tagRepo.AddToTask(ctx, tx, taskID, req.TagID); err != nil {
+			return err
+		}
+		return s.activityRepo.Create(ctx, tx, newActivity(task.ID, currentUserID(ctx), domain.ActivityTagAdded, domain.ActivityMetadata(map[string]any{
+			"tag": tag.Name,
+		})))
+	})
+	if err != nil {
+		s.logger.Error("Failed to add tag to task", err, map[string]interface{}{
+			"task_id": taskID,
+			"tag_id":  req.TagID,
+		})
+		return err
+	}
+
+	s.logger.Info("Tag added to task", map[string]interface{}{
+		"action":  "TASK_TAG_CREATE",
+		"task_id": taskID,
+		"tag":     tag.Name,
+	})
+
+	return nil
+}
+
+func (s *tagService) RemoveTagFromTask(ctx context.Context, taskID string, tagID string) error {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		s.logger.Error("Failed to get task", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return err
+	}
+	if task == nil {
+		return domain.ErrTaskNotFound{}
+	}
+
+	tagName := tagID
+	if tag, err := s.tagRepo.GetByID(ctx, tagID); err == nil && tag != nil {
+		tagName = tag.Name
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.tagRepo.RemoveFromTask(ctx, tx, taskID, tagID); err != nil {
+			return err
+		}
+		return s.activityRepo.Create(ctx, tx, newActivity(task.ID, currentUserID(ctx), domain.ActivityTagRemoved, domain.ActivityMetadata(map[string]any{
+			"tag": tagName,
+		})))
+	})
+	if err != nil {
+		s.logger.Error("Failed to remove tag from task", err, map[string]interface{}{
+			"task_id": taskID,
+			"tag_id":  tagID,
+		})
+		return err
+	}
+
+	s.logger.Info("Tag removed from task", map[string]interface{}{
+		"action":  "TASK_TAG_DELETE",
+		"task_id": taskID,
+		"tag_id":  tagID,
+	})
+
+	return nil
+}
@@ -0,0 +1,312 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/events"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/database"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/outbox"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type TeamAssignmentService interface {
+	GetTaskTeams(ctx context.Context, taskID string) ([]domain.TeamAssignment, error)
+	AssignTeam(ctx context.Context, taskID string, req *domain.AssignTeamRequest) (*domain.TeamAssignment, error)
+	UnassignTeam(ctx context.Context, teamAssignmentID string) error
+	DistributeTask(ctx context.Context, teamAssignmentID string, req *domain.DistributeTaskRequest) (*domain.TaskAssignment, error)
+}
+
+type teamAssignmentService struct {
+	teamAssignRepo domain.TeamAssignmentRepository
+	taskRepo       domain.TaskRepository
+	assignRepo     domain.AssignmentRepository
+	activityRepo   domain.ActivityRepository
+	teamProvider   domain.TeamProvider
+	outboxRepo     outbox.Repository
+	uow            database.UnitOfWork
+	logger         logger.Logger
+}
+
+func NewTeamAssignmentService(
+	teamAssignRepo domain.TeamAssignmentRepository,
+	taskRepo domain.TaskRepository,
+	assignRepo domain.AssignmentRepository,
+	activityRepo domain.ActivityRepository,
+	teamProvider domain.TeamProvider,
+	outboxRepo outbox.Repository,
+	uow database.UnitOfWork,
+	logger logger.Logger,
+) TeamAssignmentService {
+	return &teamAssignmentService{
+		teamAssignRepo: teamAssignRepo,
+		taskRepo:       taskRepo,
+		assignRepo:     assignRepo,
+		activityRepo:   activityRepo,
+		teamProvider:   teamProvider,
+		outboxRepo:     outboxRepo,
+		uow:            uow,
+		logger:         logger,
+	}
+}
+
+func (s *teamAssignmentService) GetTaskTeams(ctx context.Context, taskID string) ([]domain.TeamAssignment, error) {
+	assignments, err := s.teamAssignRepo.GetByTask(ctx, taskID)
+	if err != nil {
+		s.logger.Error("Failed to get task team assignments", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return nil, err
+	}
+	return assignments, nil
+}
+
+// AssignTeam task'ı takıma atar ve takımın aktif üyelerine bildirim için tek bir
+// TaskTeamAssignedEvent yazar. Üyelere kullanıcı ataması yapılmaz; bunu takım lideri
+// DistributeTask ile yapar.
+func (s *teamAssignmentService) AssignTeam(ctx context.Context, taskID string, req *domain.AssignTeamRequest) (*domain.TeamAssignment, error) {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		s.logger.Error("Failed to get task", err, map[string]interface{}{
+			"task_id": taskID,
+		})
+		return nil, err
+	}
+	if task == nil {
+		return nil, domain.ErrTaskNotFound{}
+	}
+
+	team, err := s.teamProvider.GetTeam(ctx, uuid.MustParse(req.TeamID))
+	if err != nil {
+		s.logger.Error("Failed to get team", err, map[string]interface{}{
+			"team_id": req.TeamID,
+		})
+		return nil, err
+	}
+	if team == nil {
+		return nil, domain.ErrTeamNotFound{}
+	}
+
+	actorID := currentUserID(ctx)
+	assignment := &domain.TeamAssignment{
+		ID:         uuid.New(),
+		TaskID:     task.ID,
+		TeamID:     team.ID,
+		TeamName:   team.Name,
+		AssignedBy: actorID,
+		CreatedAt:  time.Now(),
+	}
+
+	recipients := make([]events.Recipient, 0, len(team.Members))
+	for _, member := range team.Members {
+		recipients = append(recipients, events.Recipient{
+			UserID:    member.UserID.String(),
+			UserName:  member.Username,
+			UserEmail: member.Email,
+		})
+	}
+	event := events.TaskTeamAssignedEvent{
+		TaskID:         task.ID.String(),
+		TaskTitle:      task.Title,
+		TeamID:         team.ID.String(),
+		TeamName:       team.Name,
+		AssignedBy:     actorID.String(),
+		AssignedByName: utils.GetUsernameFromContext(ctx),
+		Recipients:     recipients,
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.teamAssignRepo.Create(ctx, tx, assignment); err != nil {
+			return err
+		}
+
+		activity := newActivity(task.ID, actorID, domain.ActivityTeamAssigned, domain.ActivityMetadata(map[string]any{
+			"team_assignment_id": assignment.ID,
+			"team_id":            team.ID,
+			"team_name":          team.Name,
+		}))
+		if err := s.activityRepo.Create(ctx, tx, activity); err != nil {
+			return err
+		}
+
+		return writeTaskOutbox(ctx, s.outboxRepo, s.logger, tx, task.ID, events.TopicTaskTeamAssigned, event)
+	})
+	if err != nil {
+		if _, ok := err.(domain.ErrTeamAlreadyAssigned); !ok {
+			s.logger.Error("Failed to assign task to team", err, map[string]interface{}{
+				"task_id": taskID,
+				"team_id": req.TeamID,
+			})
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Task assigned to team", map[string]interface{}{
+		"action":     "TASK_TEAM_ASSIGN",
+		"task_id":    taskID,
+		"team_id":    req.TeamID,
+		"recipients": len(recipients),
+	})
+
+	return assignment, nil
+}
+
+// UnassignTeam takım atamasını kaldırır. Lider tarafından dağıtılmış kullanıcı atamaları
+// korunur.
+func (s *teamAssignmentService) UnassignTeam(ctx context.Context, teamAssignmentID string) error {
+	assignment, err := s.teamAssignRepo.GetByID(ctx, teamAssignmentID)
+	if err != nil {
+		s.logger.Error("Failed to get team assignment", err, map[string]interface{}{
+			"team_assignment_id": teamAssignmentID,
+		})
+		return err
+	}
+	if assignment == nil {
+		return domain.ErrTeamAssignmentNotFound{}
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.teamAssignRepo.Delete(ctx, tx, teamAssignmentID); err != nil {
+			return err
+		}
+		return s.activityRepo.Create(ctx, tx, newActivity(assignment.TaskID, currentUserID(ctx), domain.ActivityTeamUnassigned, domain.ActivityMetadata(map[string]any{
+			"team_assignment_id": assignment.ID,
+			"team_id":            assignment.TeamID,
+			"team_name":          assignment.TeamName,
+		})))
+	})
+	if err != nil {
+		if _, ok := err.(domain.ErrTeamAssignmentNotFound); !ok {
+			s.logger.Error("Failed to unassign team", err, map[string]interface{}{
+				"team_assignment_id": teamAssignmentID,
+			})
+		}
+		return err
+	}
+
+	s.logger.Info("Team unassigned from task", map[string]interface{}{
+		"action":             "TASK_TEAM_UNASSIGN",
+		"team_assignment_id": teamAssignmentID,
+		"task_id":            assignment.TaskID.String(),
+		"team_id":            assignment.TeamID.String(),
+	})
+
+	return nil
+}
+
+// DistributeTask takıma atanmış task'ı takımın bir üyesine atar. Sadece takımın lideri
+// (veya ADMIN) dağıtım yapabilir; oluşan atama takıma bağlı kalır.
+func (s *teamAssignmentService) DistributeTask(ctx context.Context, teamAssignmentID string, req *domain.DistributeTaskRequest) (*domain.TaskAssignment, error) {
+	teamAssignment, err := s.teamAssignRepo.GetByID(ctx, teamAssignmentID)
+	if err != nil {
+		s.logger.Error("Failed to get team assignment", err, map[string]interface{}{
+			"team_assignment_id": teamAssignmentID,
+		})
+		return nil, err
+	}
+	if teamAssignment == nil {
+		return nil, domain.ErrTeamAssignmentNotFound{}
+	}
+
+	task, err := s.taskRepo.GetByID(ctx, teamAssignment.TaskID.String())
+	if err != nil {
+		s.logger.Error("Failed to get task", err, map[string]interface{}{
+			"task_id": teamAssignment.TaskID.String(),
+		})
+		return nil, err
+	}
+	if task == nil {
+		return nil, domain.ErrTaskNotFound{}
+	}
+
+	team, err := s.teamProvider.GetTeam(ctx, teamAssignment.TeamID)
+	if err != nil {
+		s.logger.Error("Failed to get team", err, map[string]interface{}{
+			"team_id": teamAssignment.TeamID.String(),
+		})
+		return nil, err
+	}
+	if team == nil {
+		return nil, domain.ErrTeamNotFound{}
+	}
+
+	actorID := currentUserID(ctx)
+	if utils.GetRoleFromContext(ctx) != "ADMIN" {
+		if lead := team.Member(actorID); lead == nil || !lead.IsLead {
+			return nil, domain.ErrNotTeamLead{}
+		}
+	}
+
+	userID := uuid.MustParse(req.UserID)
+	member := team.Member(userID)
+	if member == nil {
+		return nil, domain.ErrNotTeamMember{}
+	}
+
+	current, err := s.assignRepo.GetByTask(ctx, task.ID.String())
+	if err != nil {
+		s.logger.Error("Failed to get task assignments", err, map[string]interface{}{
+			"task_id": task.ID.String(),
+		})
+		return nil, err
+	}
+	for _, a := range current {
+		if a.UserID == userID {
+			return nil, domain.ErrAlreadyAssigned{}
+		}
+	}
+
+	assignment := &domain.TaskAssignment{
+		ID:        uuid.New(),
+		TaskID:    task.ID,
+		UserID:    userID,
+		CreatedAt: time.Now(),
+		TeamID:    &team.ID,
+	}
+	event := events.TaskAssignedEvent{
+		TaskID:    task.ID.String(),
+		TaskTitle: task.Title,
+		UserID:    userID.String(),
+		UserEmail: member.Email,
+		UserName:  member.Username,
+		TeamID:    team.ID.String(),
+		TeamName:  team.Name,
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		if err := s.assignRepo.Create(ctx, tx, assignment); err != nil {
+			return err
+		}
+
+		activity := newActivity(task.ID, actorID, domain.ActivityAssignmentAdded, domain.ActivityMetadata(map[string]any{
+			"assignment_id": assignment.ID,
+			"assignee_id":   userID,
+			"team_id":       team.ID,
+		}))
+		if err := s.activityRepo.Create(ctx, tx, activity); err != nil {
+			return err
+		}
+
+		return writeTaskOutbox(ctx, s.outboxRepo, s.logger, tx, task.ID, events.TopicTaskAssigned, event)
+	})
+	if err != nil {
+		s.logger.Error("Failed to distribute team task", err, map[string]interface{}{
+			"team_assignment_id": teamAssignmentID,
+			"user_id":            req.UserID,
+		})
+		return nil, err
+	}
+
+	s.logger.Info("Team task distributed", map[string]interface{}{
+		"action":  "TASK_DISTRIBUTE",
+		"task_id": task.ID.String(),
+		"team_id": team.ID.String(),
+		"user_id": req.UserID,
+	})
+
+	return assignment, nil
+}
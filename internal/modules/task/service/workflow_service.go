@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/google/uuid"
+)
+
+type WorkflowService interface {
+	GetWorkflow(ctx context.Context) (*domain.Workflow, error)
+	CreateState(ctx context.Context, req *domain.CreateWorkflowStateRequest) (*domain.WorkflowState, error)
+	DeleteState(ctx context.Context, key string) error
+	CreateTransition(ctx context.Context, req *domain.CreateWorkflowTransitionRequest) (*domain.WorkflowTransition, error)
+	DeleteTransition(ctx context.Context, transitionID string) error
+}
+
+type workflowService struct {
+	repo   domain.WorkflowRepository
+	logger logger.Logger
+}
+
+func NewWorkflowService(repo domain.WorkflowRepository, logger logger.Logger) WorkflowService {
+	return &workflowService{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *workflowService) GetWorkflow(ctx context.Context) (*domain.Workflow, error) {
+	workflow, err := s.repo.GetWorkflow(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get workflow", err, nil)
+		return nil, err
+	}
+	return workflow, nil
+}
+
+func (s *workflowService) CreateState(ctx context.Context, req *domain.CreateWorkflowStateRequest) (*domain.WorkflowState, error) {
+	state := &domain.WorkflowState{
+		Key:       domain.TaskStatus(req.Key),
+		Name:      req.Name,
+		IsInitial: req.IsInitial,
+		IsFinal:   req.IsFinal,
+		Position:  req.Position,
+	}
+
+	if err := s.repo.CreateState(ctx, state); err != nil {
+		s.logger.Error("Failed to create workflow state", err, map[string]interface{}{
+			"key": req.Key,
+		})
+		return nil, err
+	}
+
+	s.logger.Info("Workflow state created", map[string]interface{}{
+		"action": "WORKFLOW_STATE_CREATE",
+		"actor":  utils.GetUsernameFromContext(ctx),
+		"key":    state.Key,
+	})
+
+	return state, nil
+}
+
+func (s *workflowService) DeleteState(ctx context.Context, key string) error {
+	if err := s.repo.DeleteState(ctx, domain.TaskStatus(key)); err != nil {
+		s.logger.Error("Failed to delete workflow state", err, map[string]interface{}{
+			"key": key,
+		})
+		return err
+	}
+
+	s.logger.Info("Workflow state deleted", map[string]interface{}{
+		"action": "WORKFLOW_STATE_DELETE",
+		"actor":  utils.GetUsernameFromContext(ctx),
+		"key":    key,
+	})
+
+	return nil
+}
+
+func (s *workflowService) CreateTransition(ctx context.Context, req *domain.CreateWorkflowTransitionRequest) (*domain.WorkflowTransition, error) {
+	for _, key := range []string{req.FromState, req.ToState} {
+		state, err := s.repo.GetState(ctx, domain.TaskStatus(key))
+		if err != nil {
+			s.logger.Error("Failed to get workflow state", err, map[string]interface{}{
+				"key": key,
+			})
+			return nil, err
+		}
+		if state == nil {
+			return nil, domain.ErrUnknownStatus{Status: domain.TaskStatus(key)}
+		}
+	}
+
+	transition := &domain.WorkflowTransition{
+		ID:        uuid.New(),
+		FromState: domain.TaskStatus(req.FromState),
+		ToState:   domain.TaskStatus(req.ToState),
+	}
+	if req.RequiredRole != "" {
+		transition.RequiredRole = &req.RequiredRole
+	}
+
+	if err := s.repo.CreateTransition(ctx, transition); err != nil {
+		s.logger.Error("Failed to create workflow transition", err, map[string]interface{}{
+			"from": req.FromState,
+			"to":   req.ToState,
+		})
+		return nil, err
+	}
+
+	s.logger.Info("Workflow transition created", map[string]interface{}{
+		"action":        "WORKFLOW_TRANSITION_CREATE",
+		"actor":         utils.GetUsernameFromContext(ctx),
+		"from":          transition.FromState,
+		"to":            transition.ToState,
+		"required_role": req.RequiredRole,
+	})
+
+	return transition, nil
+}
+
+func (s *workflowService) DeleteTransition(ctx context.Context, transitionID string) error {
+	if err := s.repo.DeleteTransition(ctx, transitionID); err != nil {
+		s.logger.Error("Failed to delete workflow transition", err, map[string]interface{}{
+			"transition_id": transitionID,
+		})
+		return err
+	}
+
+	s.logger.Info("Workflow transition deleted", map[string]interface{}{
+		"action":        "WORKFLOW_TRANSITION_DELETE",
+		"actor":         utils.GetUsernameFromContext(ctx),
+		"transition_id": transitionID,
+	})
+
+	return nil
+}
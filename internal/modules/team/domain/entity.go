@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MemberRoleMember = "member"
+	MemberRoleLead   = "lead"
+)
+
+type Team struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	Name        string    `json:"name" db:"name"`
+	Description string    `json:"description" db:"description"`
+	MemberCount int       `json:"member_count" db:"member_count"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+
+	// Members sadece tek takım getirilirken doldurulur.
+	Members []Member `json:"members,omitempty" db:"-"`
+}
+
+// Member takım üyeliğini kullanıcının güncel profil ve durum bilgisiyle taşır.
+type Member struct {
+	UserID   uuid.UUID `json:"user_id" db:"user_id"`
+	Username string    `json:"username" db:"username"`
+	Ad       string    `json:"ad" db:"ad"`
+	Soyad    string    `json:"soyad" db:"soyad"`
+	Email    string    `json:"email" db:"email"`
+	Status   string    `json:"status" db:"status"`
+	Role     string    `json:"role" db:"role"`
+	JoinedAt time.Time `json:"joined_at" db:"joined_at"`
+}
+
+func (m *Member) IsLead() bool {
+	return m.Role == MemberRoleLead
+}
+
+type CreateTeamRequest struct {
+	Name        string `json:"name" validate:"required,min=2,max=100"`
+	Description string `json:"description" validate:"max=255"`
+}
+
+type UpdateTeamRequest struct {
+	Name        *string `json:"name" validate:"omitnil,min=2,max=100"`
+	Description *string `json:"description" validate:"omitnil,max=255"`
+}
+
+// SetMemberRequest kullanıcıyı takıma ekler veya takımdaki rolünü değiştirir.
+type SetMemberRequest struct {
+	Role string `json:"role" validate:"omitempty,oneof=member lead"`
+}
+
+type ErrTeamNotFound struct{}
+
+func (e ErrTeamNotFound) Error() string {
+	return "team not found"
+}
+
+type ErrTeamNameTaken struct{}
+
+func (e ErrTeamNameTaken) Error() string {
+	return "team name already taken"
+}
+
+type ErrMemberNotFound struct{}
+
+func (e ErrMemberNotFound) Error() string {
+	return "user is not a member of the team"
+}
+
+type ErrInactiveUser struct{}
+
+func (e ErrInactiveUser) Error() string {
+	return "suspended or deleted users cannot join a team"
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type TeamRepository interface {
+	List(ctx context.Context) ([]Team, error)
+	GetByID(ctx context.Context, teamID uuid.UUID) (*Team, error)
+	Create(ctx context.Context, team *Team) error
+	Update(ctx context.Context, team *Team) error
+	Delete(ctx context.Context, teamID uuid.UUID) error
+
+	GetMembers(ctx context.Context, teamID uuid.UUID) ([]Member, error)
+	// SetMember kullanıcıyı takıma ekler; zaten üyeyse sadece rolünü günceller.
+	SetMember(ctx context.Context, teamID, userID uuid.UUID, role string) error
+	RemoveMember(ctx context.Context, teamID, userID uuid.UUID) error
+}
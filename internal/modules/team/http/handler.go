@@ -0,0 +1,195 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/team/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/team/service"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type TeamHandler struct {
+	service  service.TeamService
+	validate *validator.Validate
+}
+
+func NewHandler(svc service.TeamService) *TeamHandler {
+	return &TeamHandler{
+		service:  svc,
+		validate: validation.Get(),
+	}
+}
+
+func (h *TeamHandler) ListTeams(w http.ResponseWriter, r *http.Request) {
+	teams, err := h.service.ListTeams(r.Context())
+	if err != nil {
+		resp := utils.ErrorResponse("INTERNAL_ERROR", "Takımlar getirilemedi", err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+		return
+	}
+
+	utils.WriteJson(w, teams, http.StatusOK, "Takımlar başarıyla getirildi")
+}
+
+func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
+	teamID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	team, err := h.service.GetTeam(r.Context(), teamID)
+	if err != nil {
+		h.writeError(w, err, "Takım getirilemedi")
+		return
+	}
+
+	utils.WriteJson(w, team, http.StatusOK, "Takım başarıyla getirildi")
+}
+
+func (h *TeamHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
+	var req domain.CreateTeamRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	team, err := h.service.CreateTeam(r.Context(), &req)
+	if err != nil {
+		h.writeError(w, err, "Takım oluşturulamadı")
+		return
+	}
+
+	utils.WriteJson(w, team, http.StatusCreated, "Takım başarıyla oluşturuldu")
+}
+
+func (h *TeamHandler) UpdateTeam(w http.ResponseWriter, r *http.Request) {
+	teamID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateTeamRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	team, err := h.service.UpdateTeam(r.Context(), teamID, &req)
+	if err != nil {
+		h.writeError(w, err, "Takım güncellenemedi")
+		return
+	}
+
+	utils.WriteJson(w, team, http.StatusOK, "Takım başarıyla güncellendi")
+}
+
+func (h *TeamHandler) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+	teamID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteTeam(r.Context(), teamID); err != nil {
+		h.writeError(w, err, "Takım silinemedi")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Takım başarıyla silindi")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func (h *TeamHandler) SetMember(w http.ResponseWriter, r *http.Request) {
+	teamID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+	userID, ok := parseID(w, r, "userId")
+	if !ok {
+		return
+	}
+
+	var req domain.SetMemberRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	team, err := h.service.SetMember(r.Context(), teamID, userID, &req)
+	if err != nil {
+		h.writeError(w, err, "Takım üyesi eklenemedi")
+		return
+	}
+
+	utils.WriteJson(w, team, http.StatusOK, "Takım üyesi başarıyla kaydedildi")
+}
+
+func (h *TeamHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	teamID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+	userID, ok := parseID(w, r, "userId")
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveMember(r.Context(), teamID, userID); err != nil {
+		h.writeError(w, err, "Takım üyesi çıkarılamadı")
+		return
+	}
+
+	resp := utils.SuccessResponse(nil, "Takım üyesi başarıyla çıkarıldı")
+	utils.Return(w, http.StatusOK, resp)
+}
+
+func parseID(w http.ResponseWriter, r *http.Request, key string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(mux.Vars(r)[key])
+	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz UUID formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func (h *TeamHandler) decode(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	return true
+}
+
+func (h *TeamHandler) writeError(w http.ResponseWriter, err error, message string) {
+	switch err.(type) {
+	case domain.ErrTeamNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Takım bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrMemberNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Kullanıcı bu takımın üyesi değil", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case userDomain.ErrUserNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Kullanıcı bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+	case domain.ErrTeamNameTaken:
+		resp := utils.ErrorResponse("CONFLICT", "Bu isimde bir takım zaten var", err.Error())
+		utils.Return(w, http.StatusConflict, resp)
+	case domain.ErrInactiveUser:
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Askıya alınmış veya silinmiş kullanıcılar takıma eklenemez", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	default:
+		resp := utils.ErrorResponse("INTERNAL_ERROR", message, err.Error())
+		utils.Return(w, http.StatusInternalServerError, resp)
+	}
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/team/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const teamColumns = `
+	t.id, t.name, t.description, t.created_at, t.updated_at,
+	(SELECT COUNT(*) FROM team_members tm WHERE tm.team_id = t.id) AS member_count
+`
+
+type PostgresTeamRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresTeamRepository(db *sqlx.DB) domain.TeamRepository {
+	return &PostgresTeamRepository{db: db}
+}
+
+func (r *PostgresTeamRepository) List(ctx context.Context) ([]domain.Team, error) {
+	teams := []domain.Team{}
+	query := `SELECT ` + teamColumns + ` FROM teams t ORDER BY t.name ASC`
+	if err := r.db.SelectContext(ctx, &teams, query); err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+func (r *PostgresTeamRepository) GetByID(ctx context.Context, teamID uuid.UUID) (*domain.Team, error) {
+	team := &domain.Team{}
+	query := `SELECT ` + teamColumns + ` FROM teams t WHERE t.id = $1`
+	err := r.db.GetContext(ctx, team, query, teamID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return team, nil
+}
+
+func (r *PostgresTeamRepository) Create(ctx context.Context, team *domain.Team) error {
+	query := `
+		INSERT INTO teams (id, name, description, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := r.db.ExecContext(ctx, query, team.ID, team.Name, team.Description, team.CreatedAt, team.UpdatedAt)
+	return mapTeamError(err)
+}
+
+func (r *PostgresTeamRepository) Update(ctx context.Context, team *domain.Team) error {
+	query := `UPDATE teams SET name = $1, description = $2, updated_at = $3 WHERE id = $4`
+	res, err := r.db.ExecContext(ctx, query, team.Name, team.Description, team.UpdatedAt, team.ID)
+	if err != nil {
+		return mapTeamError(err)
+	}
+	return requireAffected(res, domain.ErrTeamNotFound{})
+}
+
+func (r *PostgresTeamRepository) Delete(ctx context.Context, teamID uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM teams WHERE id = $1`, teamID)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res, domain.ErrTeamNotFound{})
+}
+
+func (r *PostgresTeamRepository) GetMembers(ctx context.Context, teamID uuid.UUID) ([]domain.Member, error) {
+	members := []domain.Member{}
+	query := `
+		SELECT tm.user_id, u.username, COALESCE(u.ad, '') AS ad, COALESCE(u.soyad, '') AS soyad,
+			COALESCE(u.email, '') AS email, u.status, tm.role, tm.joined_at
+		FROM team_members tm
+		INNER JOIN users u ON u.id = tm.user_id
+		WHERE tm.team_id = $1
+		ORDER BY tm.role = 'lead' DESC, u.username ASC
+	`
+	if err := r.db.SelectContext(ctx, &members, query, teamID); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (r *PostgresTeamRepository) SetMember(ctx context.Context, teamID, userID uuid.UUID, role string) error {
+	query := `
+		INSERT INTO team_members (team_id, user_id, role)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (team_id, user_id) DO UPDATE SET role = EXCLUDED.role
+	`
+	_, err := r.db.ExecContext(ctx, query, teamID, userID, role)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return domain.ErrTeamNotFound{}
+	}
+	return err
+}
+
+func (r *PostgresTeamRepository) RemoveMember(ctx context.Context, teamID, userID uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM team_members WHERE team_id = $1 AND user_id = $2`, teamID, userID)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res, domain.ErrMemberNotFound{})
+}
+
+func mapTeamError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return domain.ErrTeamNameTaken{}
+	}
+	return err
+}
+
+func requireAffected(res sql.Result, notFound error) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return notFound
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+
+	taskDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/team/domain"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/google/uuid"
+)
+
+type TeamProviderAdapter struct {
+	teamRepo domain.TeamRepository
+}
+
+func NewTeamProviderAdapter(teamRepo domain.TeamRepository) taskDomain.TeamProvider {
+	return &TeamProviderAdapter{
+		teamRepo: teamRepo,
+	}
+}
+
+func (a *TeamProviderAdapter) GetTeam(ctx context.Context, teamID uuid.UUID) (*taskDomain.TeamInfo, error) {
+	team, err := a.teamRepo.GetByID(ctx, teamID)
+	if err != nil || team == nil {
+		return nil, err
+	}
+
+	members, err := a.teamRepo.GetMembers(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &taskDomain.TeamInfo{
+		ID:      team.ID,
+		Name:    team.Name,
+		Members: make([]taskDomain.TeamMemberInfo, 0, len(members)),
+	}
+	for _, member := range members {
+		if member.Status != userDomain.StatusActive {
+			continue
+		}
+		info.Members = append(info.Members, taskDomain.TeamMemberInfo{
+			UserID:   member.UserID,
+			Username: member.Username,
+			Email:    member.Email,
+			IsLead:   member.IsLead(),
+		})
+	}
+	return info, nil
+}
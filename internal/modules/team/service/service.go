@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/team/domain"
+	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/google/uuid"
+)
+
+type TeamService interface {
+	ListTeams(ctx context.Context) ([]domain.Team, error)
+	GetTeam(ctx context.Context, teamID uuid.UUID) (*domain.Team, error)
+	CreateTeam(ctx context.Context, req *domain.CreateTeamRequest) (*domain.Team, error)
+	UpdateTeam(ctx context.Context, teamID uuid.UUID, req *domain.UpdateTeamRequest) (*domain.Team, error)
+	DeleteTeam(ctx context.Context, teamID uuid.UUID) error
+
+	SetMember(ctx context.Context, teamID, userID uuid.UUID, req *domain.SetMemberRequest) (*domain.Team, error)
+	RemoveMember(ctx context.Context, teamID, userID uuid.UUID) error
+}
+
+type teamService struct {
+	repo     domain.TeamRepository
+	userRepo userDomain.UserRepository
+	logger   logger.Logger
+}
+
+func NewTeamService(repo domain.TeamRepository, userRepo userDomain.UserRepository, logger logger.Logger) TeamService {
+	return &teamService{
+		repo:     repo,
+		userRepo: userRepo,
+		logger:   logger,
+	}
+}
+
+func (s *teamService) ListTeams(ctx context.Context) ([]domain.Team, error) {
+	teams, err := s.repo.List(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list teams", err, nil)
+		return nil, err
+	}
+	return teams, nil
+}
+
+// GetTeam takımı liderler önce olacak şekilde üyeleriyle birlikte döner.
+func (s *teamService) GetTeam(ctx context.Context, teamID uuid.UUID) (*domain.Team, error) {
+	team, err := s.repo.GetByID(ctx, teamID)
+	if err != nil {
+		s.logger.Error("Failed to get team", err, map[string]interface{}{
+			"team_id": teamID.String(),
+		})
+		return nil, err
+	}
+	if team == nil {
+		return nil, domain.ErrTeamNotFound{}
+	}
+
+	members, err := s.repo.GetMembers(ctx, teamID)
+	if err != nil {
+		s.logger.Error("Failed to get team members", err, map[string]interface{}{
+			"team_id": teamID.String(),
+		})
+		return nil, err
+	}
+	team.Members = members
+
+	return team, nil
+}
+
+func (s *teamService) CreateTeam(ctx context.Context, req *domain.CreateTeamRequest) (*domain.Team, error) {
+	now := time.Now()
+	team := &domain.Team{
+		ID:          uuid.New(),
+		Name:        strings.TrimSpace(req.Name),
+		Description: strings.TrimSpace(req.Description),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Members:     []domain.Member{},
+	}
+
+	if err := s.repo.Create(ctx, team); err != nil {
+		if _, ok := err.(domain.ErrTeamNameTaken); !ok {
+			s.logger.Error("Failed to create team", err, map[string]interface{}{
+				"name": team.Name,
+			})
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Team created", map[string]interface{}{
+		"action":  "TEAM_CREATE",
+		"actor":   utils.GetUsernameFromContext(ctx),
+		"team_id": team.ID.String(),
+		"name":    team.Name,
+	})
+
+	return team, nil
+}
+
+func (s *teamService) UpdateTeam(ctx context.Context, teamID uuid.UUID, req *domain.UpdateTeamRequest) (*domain.Team, error) {
+	team, err := s.GetTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		team.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		team.Description = strings.TrimSpace(*req.Description)
+	}
+	team.UpdatedAt = time.Now()
+
+	if err := s.repo.Update(ctx, team); err != nil {
+		switch err.(type) {
+		case domain.ErrTeamNameTaken, domain.ErrTeamNotFound:
+		default:
+			s.logger.Error("Failed to update team", err, map[string]interface{}{
+				"team_id": teamID.String(),
+			})
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Team updated", map[string]interface{}{
+		"action":  "TEAM_UPDATE",
+		"actor":   utils.GetUsernameFromContext(ctx),
+		"team_id": teamID.String(),
+		"name":    team.Name,
+	})
+
+	return team, nil
+}
+
+// DeleteTeam takımı ve task'lara yapılan takım atamalarını siler. Üyelere dağıtılmış
+// kullanıcı atamaları korunur, sadece takım bağları kalkar.
+func (s *teamService) DeleteTeam(ctx context.Context, teamID uuid.UUID) error {
+	if err := s.repo.Delete(ctx, teamID); err != nil {
+		if _, ok := err.(domain.ErrTeamNotFound); !ok {
+			s.logger.Error("Failed to delete team", err, map[string]interface{}{
+				"team_id": teamID.String(),
+			})
+		}
+		return err
+	}
+
+	s.logger.Info("Team deleted", map[string]interface{}{
+		"action":  "TEAM_DELETE",
+		"actor":   utils.GetUsernameFromContext(ctx),
+		"team_id": teamID.String(),
+	})
+
+	return nil
+}
+
+// SetMember kullanıcıyı takıma ekler veya rolünü değiştirir. Bir takımın birden fazla
+// lideri olabilir. Askıya alınmış veya silinmiş kullanıcılar eklenemez.
+func (s *teamService) SetMember(ctx context.Context, teamID, userID uuid.UUID, req *domain.SetMemberRequest) (*domain.Team, error) {
+	user, err := s.userRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, userDomain.ErrUserNotFound{}
+		}
+		s.logger.Error("Failed to get user", err, map[string]interface{}{
+			"user_id": userID.String(),
+		})
+		return nil, err
+	}
+	if !user.IsActive() {
+		return nil, domain.ErrInactiveUser{}
+	}
+
+	role := req.Role
+	if role == "" {
+		role = domain.MemberRoleMember
+	}
+
+	if err := s.repo.SetMember(ctx, teamID, userID, role); err != nil {
+		if _, ok := err.(domain.ErrTeamNotFound); !ok {
+			s.logger.Error("Failed to set team member", err, map[string]interface{}{
+				"team_id": teamID.String(),
+				"user_id": userID.String(),
+			})
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Team member set", map[string]interface{}{
+		"action":  "TEAM_MEMBER_SET",
+		"actor":   utils.GetUsernameFromContext(ctx),
+		"team_id": teamID.String(),
+		"user_id": userID.String(),
+		"role":    role,
+	})
+
+	return s.GetTeam(ctx, teamID)
+}
+
+func (s *teamService) RemoveMember(ctx context.Context, teamID, userID uuid.UUID) error {
+	if err := s.repo.RemoveMember(ctx, teamID, userID); err != nil {
+		if _, ok := err.(domain.ErrMemberNotFound); !ok {
+			s.logger.Error("Failed to remove team member", err, map[string]interface{}{
+				"team_id": teamID.String(),
+				"user_id": userID.String(),
+			})
+		}
+		return err
+	}
+
+	s.logger.Info("Team member removed", map[string]interface{}{
+		"action":  "TEAM_MEMBER_REMOVE",
+		"actor":   utils.GetUsernameFromContext(ctx),
+		"team_id": teamID.String(),
+		"user_id": userID.String(),
+	})
+
+	return nil
+}
@@ -1,6 +1,10 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type User struct {
 	Id       uuid.UUID `json:"id" db:"id"`
@@ -11,16 +15,37 @@ type User struct {
 	Soyad    string    `json:"soyad" db:"soyad"`
 	Telefon  string    `json:"telefon" db:"telefon"`
 	Email    string    `json:"email" db:"email"`
+
+	Status       string     `json:"status" db:"status"`
+	StatusReason string     `json:"status_reason,omitempty" db:"status_reason"`
+	DeletedAt    *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+}
+
+func (u *User) IsActive() bool {
+	return u.Status == StatusActive
 }
 
 type CreateUserRequest struct {
 	Username string `json:"username" validate:"required"`
-	Password string `json:"password" validate:"required,min=3"`
+	Password string `json:"password" validate:"required,password"`
 	Role     string `json:"role" validate:"required"`
-	Ad       string `json:"ad"`
-	Soyad    string `json:"soyad"`
-	Telefon  string `json:"telefon"`
-	Email    string `json:"email"`
+	Ad       string `json:"ad" validate:"max=100"`
+	Soyad    string `json:"soyad" validate:"max=100"`
+	Telefon  string `json:"telefon" validate:"omitempty,phone"`
+	Email    string `json:"email" validate:"omitempty,email,max=150"`
+}
+
+// UpdateProfileRequest kısmi güncelleme isteğidir; gönderilmeyen alanlar değişmez, boş
+// string alanı temizler.
+type UpdateProfileRequest struct {
+	Ad      *string `json:"ad" validate:"omitnil,max=100"`
+	Soyad   *string `json:"soyad" validate:"omitnil,max=100"`
+	Telefon *string `json:"telefon" validate:"omitempty,phone"`
+	Email   *string `json:"email" validate:"omitempty,email,max=150"`
+}
+
+type ChangeRoleRequest struct {
+	Role string `json:"role" validate:"required,max=20"`
 }
 
 type ErrUserNotFound struct{}
@@ -34,3 +59,44 @@ type ErrUsernameTaken struct{}
 func (e ErrUsernameTaken) Error() string {
 	return "username already taken"
 }
+
+type ErrUnknownRole struct{}
+
+func (e ErrUnknownRole) Error() string {
+	return "role does not exist"
+}
+
+// ErrLastAdmin son aktif ADMIN kullanıcısının rolü değiştirilmek veya hesabı devre dışı
+// bırakılmak istendiğinde döner.
+type ErrLastAdmin struct{}
+
+func (e ErrLastAdmin) Error() string {
+	return "cannot remove the last active admin"
+}
+
+// ErrRoleGrantForbidden role:manage yetkisi olmayan bir kullanıcı varsayılan rol dışında
+// bir rolle kullanıcı oluşturmak istediğinde döner.
+type ErrRoleGrantForbidden struct {
+	Role string
+}
+
+func (e ErrRoleGrantForbidden) Error() string {
+	return "role:manage permission is required to grant role " + e.Role
+}
+
+// ErrManageForbidden role:manage yetkisi olmayan bir kullanıcı varsayılan rol dışındaki bir
+// kullanıcının e-postasını değiştirmek, hesabını devre dışı bırakmak, 2FA kaydını sıfırlamak
+// veya oturumlarını sonlandırmak istediğinde döner.
+type ErrManageForbidden struct {
+	Role string
+}
+
+func (e ErrManageForbidden) Error() string {
+	return "role:manage permission is required to manage users with role " + e.Role
+}
+
+type ErrSelfRoleChange struct{}
+
+func (e ErrSelfRoleChange) Error() string {
+	return "users cannot change their own role"
+}
@@ -0,0 +1,58 @@
+package domain
+
+import "fmt"
+
+const MaxImportRows = 1000
+
+// İçe aktarma satır sonuçları.
+const (
+	ImportCreated   = "created"
+	ImportUpdated   = "updated"
+	ImportUnchanged = "unchanged"
+	ImportFailed    = "failed"
+)
+
+// ImportUserRow içe aktarılan tek bir kullanıcıdır. Kullanıcı adı zaten varsa profil alanları
+// güncellenir; mevcut kullanıcıların şifresi içe aktarma ile değişmez ve rolü değiştirilemez.
+type ImportUserRow struct {
+	Username string `json:"username" validate:"required,max=50"`
+	Password string `json:"password" validate:"omitempty,password"`
+	Role     string `json:"role" validate:"required,max=20"`
+	Ad       string `json:"ad" validate:"max=100"`
+	Soyad    string `json:"soyad" validate:"max=100"`
+	Telefon  string `json:"telefon" validate:"omitempty,phone"`
+	Email    string `json:"email" validate:"omitempty,email,max=150"`
+}
+
+// ImportRowResult Row, dosyadaki 1'den başlayan veri satırı numarasıdır (CSV başlığı hariç).
+type ImportRowResult struct {
+	Row      int    `json:"row"`
+	Username string `json:"username"`
+	Action   string `json:"action"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ImportResult hatalı satır varsa hiçbir değişiklik uygulanmaz; Applied false döner.
+type ImportResult struct {
+	DryRun    bool              `json:"dry_run"`
+	Applied   bool              `json:"applied"`
+	Created   int               `json:"created"`
+	Updated   int               `json:"updated"`
+	Unchanged int               `json:"unchanged"`
+	Failed    int               `json:"failed"`
+	Rows      []ImportRowResult `json:"rows"`
+}
+
+type ErrEmptyImport struct{}
+
+func (e ErrEmptyImport) Error() string {
+	return "import file has no rows"
+}
+
+type ErrImportTooLarge struct {
+	Max int
+}
+
+func (e ErrImportTooLarge) Error() string {
+	return fmt.Sprintf("import file has more than %d rows", e.Max)
+}
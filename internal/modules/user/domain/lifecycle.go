@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Kullanıcı yaşam döngüsü durumları. Kullanıcılar fiziksel olarak silinmez; task,
+// atama, yorum ve aktivite kayıtları users tablosuna referans vermeye devam eder.
+const (
+	StatusActive    = "active"
+	StatusSuspended = "suspended"
+	StatusDeleted   = "deleted"
+)
+
+// DeactivateUserRequest askıya alma ve silme isteğidir. ReassignTo verilirse kullanıcının
+// açık task atamaları bu kullanıcıya devredilir.
+type DeactivateUserRequest struct {
+	Reason     string `json:"reason" validate:"max=500"`
+	ReassignTo string `json:"reassign_to" validate:"omitempty,uuid"`
+}
+
+type DeactivateUserResponse struct {
+	User                *User `json:"user"`
+	ReassignedTaskCount int   `json:"reassigned_task_count"`
+}
+
+// TaskReassigner kullanıcının final olmayan task'lardaki atamalarını başka bir kullanıcıya
+// devreder; task modülü tarafından sağlanır.
+type TaskReassigner interface {
+	ReassignOpenAssignments(ctx context.Context, fromUserID, toUserID uuid.UUID) (int, error)
+}
+
+// PermissionChecker rolün bir yetkiye sahip olup olmadığını söyler; rbac modülü tarafından
+// sağlanır.
+type PermissionChecker interface {
+	HasPermission(ctx context.Context, role string, permission string) (bool, error)
+}
+
+type ErrInvalidStatusTransition struct {
+	From string
+	To   string
+}
+
+func (e ErrInvalidStatusTransition) Error() string {
+	return fmt.Sprintf("cannot change user status from %s to %s", e.From, e.To)
+}
+
+type ErrSelfDeactivation struct{}
+
+func (e ErrSelfDeactivation) Error() string {
+	return "users cannot deactivate their own account"
+}
+
+// ErrInvalidReassignTarget devir hedefi bulunamadığında, aktif olmadığında veya
+// devre dışı bırakılan kullanıcının kendisi olduğunda döner.
+type ErrInvalidReassignTarget struct{}
+
+func (e ErrInvalidReassignTarget) Error() string {
+	return "reassignment target must be another active user"
+}
@@ -1,15 +1,45 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
 
 type UserRepository interface {
-	GetAll() ([]User, error)
+	List(ctx context.Context, filter *UserFilter) ([]User, error)
+
+	Count(ctx context.Context, filter *UserFilter) (int, error)
+
+	GetByUsername(ctx context.Context, username string) (*User, error)
+
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*User, error)
+
+	// GetByUsernames şifre hash'i olmadan, bulunan kullanıcıları döner.
+	GetByUsernames(ctx context.Context, usernames []string) ([]User, error)
+
+	// ExistingRoles verilen rollerden tanımlı olanları döner.
+	ExistingRoles(ctx context.Context, roles []string) (map[string]bool, error)
+
+	// CountByRole roldeki aktif kullanıcıları sayar.
+	CountByRole(ctx context.Context, role string) (int, error)
+
+	// GetStatus kullanıcının yaşam döngüsü durumunu döner; kullanıcı yoksa sql.ErrNoRows.
+	GetStatus(ctx context.Context, userID uuid.UUID) (string, error)
+
+	Create(ctx context.Context, user *User) error
+
+	// CreateTx kullanıcıyı verilen transaction içinde oluşturur ve user.Id'yi doldurur.
+	CreateTx(ctx context.Context, tx *sqlx.Tx, user *User) error
 
-	GetByUsername(username string) (*User, error)
+	// UpdateProfile ad, soyad, telefon ve email alanlarını kaydeder.
+	UpdateProfile(ctx context.Context, tx *sqlx.Tx, user *User) error
 
-	GetByUserID(userID uuid.UUID) (*User, error)
+	// UpdateStatus durumu ve gerekçeyi kaydeder; deleted durumunda deleted_at doldurulur.
+	UpdateStatus(ctx context.Context, id uuid.UUID, status, reason string) error
 
-	Create(user *User) error
+	UpdatePassword(ctx context.Context, tx *sqlx.Tx, id uuid.UUID, passwordHash string) error
 
-	Delete(id uuid.UUID) error
+	UpdateRole(ctx context.Context, id uuid.UUID, role string) error
 }
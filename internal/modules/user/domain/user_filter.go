@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultUserPageSize = 20
+	MaxUserPageSize     = 100
+)
+
+// UserFilter GET /api/users query parametrelerinin parse edilmiş halidir. Sonuçlar
+// kullanıcı adına göre artan sıralıdır. Status verilmezse silinmiş kullanıcılar listelenmez.
+type UserFilter struct {
+	Query        string `json:"q" validate:"omitempty,max=100"`
+	Role         string `json:"role" validate:"omitempty,max=20"`
+	Status       string `json:"status" validate:"omitempty,oneof=active suspended deleted"`
+	Cursor       string `json:"cursor"`
+	Limit        int    `json:"limit" validate:"omitempty,min=1,max=100"`
+	IncludeTotal bool   `json:"include_total"`
+
+	// After, Cursor alanının service katmanında çözülmüş halidir.
+	After *UserCursor `json:"-"`
+}
+
+func (f *UserFilter) Normalize() {
+	if f.Limit <= 0 {
+		f.Limit = DefaultUserPageSize
+	}
+	if f.Limit > MaxUserPageSize {
+		f.Limit = MaxUserPageSize
+	}
+}
+
+type UserPage struct {
+	Items      []User `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	Total      *int   `json:"total,omitempty"`
+}
+
+// UserCursor keyset pagination için son görülen kullanıcının adını ve ID'sini taşır.
+type UserCursor struct {
+	Username string    `json:"u"`
+	ID       uuid.UUID `json:"id"`
+}
+
+func (c *UserCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeUserCursor(s string) (*UserCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor{}
+	}
+
+	cursor := &UserCursor{}
+	if err := json.Unmarshal(data, cursor); err != nil || cursor.ID == uuid.Nil {
+		return nil, ErrInvalidCursor{}
+	}
+	return cursor, nil
+}
+
+type ErrInvalidCursor struct{}
+
+func (e ErrInvalidCursor) Error() string {
+	return "invalid cursor"
+}
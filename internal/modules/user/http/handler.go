@@ -26,14 +26,32 @@ func NewHandler(svc service.UserService) *UserHandler {
 }
 
 func (h *UserHandler) UsersGet(w http.ResponseWriter, r *http.Request) {
-	users, err := h.service.ListUsers()
+	filter, err := parseUserFilter(r.URL.Query())
 	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz sorgu parametresi", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.validate.Struct(filter); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz sorgu parametresi", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	page, err := h.service.ListUsers(r.Context(), filter)
+	if err != nil {
+		if _, ok := err.(domain.ErrInvalidCursor); ok {
+			resp := utils.ErrorResponse("INVALID_CURSOR", "Geçersiz sayfalama imleci", err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+			return
+		}
 		resp := utils.ErrorResponse("INTERNAL_ERROR", "Kullanıcılar getirilemedi", err.Error())
 		utils.Return(w, http.StatusInternalServerError, resp)
 		return
 	}
 
-	utils.WriteJson(w, users, http.StatusOK, "Kullanıcılar başarıyla getirildi")
+	utils.WriteJson(w, page, http.StatusOK, "Kullanıcılar başarıyla getirildi")
 }
 
 func (h *UserHandler) UserPost(w http.ResponseWriter, r *http.Request) {
@@ -52,8 +70,20 @@ func (h *UserHandler) UserPost(w http.ResponseWriter, r *http.Request) {
 
 	user, err := h.service.CreateUser(r.Context(), &req)
 	if err != nil {
-		resp := utils.ErrorResponse("DATABASE_ERROR", "Kullanıcı oluşturulamadı (İsim kullanımda olabilir)", err.Error())
-		utils.Return(w, http.StatusInternalServerError, resp)
+		switch err.(type) {
+		case domain.ErrUnknownRole:
+			resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz rol", err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+		case domain.ErrRoleGrantForbidden:
+			resp := utils.ErrorResponse("FORBIDDEN", "Bu rolü atamak için yetkiniz yok", "Gerekli yetki: role:manage")
+			utils.Return(w, http.StatusForbidden, resp)
+		case domain.ErrUsernameTaken:
+			resp := utils.ErrorResponse("CONFLICT", "Bu kullanıcı adı kullanımda", err.Error())
+			utils.Return(w, http.StatusConflict, resp)
+		default:
+			resp := utils.ErrorResponse("DATABASE_ERROR", "Kullanıcı oluşturulamadı", err.Error())
+			utils.Return(w, http.StatusInternalServerError, resp)
+		}
 		return
 	}
 
@@ -73,14 +103,99 @@ func (h *UserHandler) UserGetByID(w http.ResponseWriter, r *http.Request) {
 
 	user, err := h.service.GetUserByID(r.Context(), id)
 	if err != nil {
-		resp := utils.ErrorResponse("NOT_FOUND", "Kullanıcı bulunamadı", err.Error())
-		utils.Return(w, http.StatusNotFound, resp)
+		writeUserError(w, err, "Kullanıcı getirilemedi")
 		return
 	}
 
 	utils.WriteJson(w, user, http.StatusOK, "Kullanıcı başarıyla getirildi")
 }
 
+func (h *UserHandler) UserPatch(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz UUID formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	h.updateProfile(w, r, id)
+}
+
+func (h *UserHandler) UserRolePut(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz UUID formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	var req domain.ChangeRoleRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	user, err := h.service.ChangeRole(r.Context(), id, &req)
+	if err != nil {
+		switch err.(type) {
+		case domain.ErrUnknownRole:
+			resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz rol", err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+		case domain.ErrSelfRoleChange:
+			resp := utils.ErrorResponse("FORBIDDEN", "Kendi rolünüzü değiştiremezsiniz", err.Error())
+			utils.Return(w, http.StatusForbidden, resp)
+		case domain.ErrLastAdmin:
+			resp := utils.ErrorResponse("CONFLICT", "Son yöneticinin rolü değiştirilemez", err.Error())
+			utils.Return(w, http.StatusConflict, resp)
+		default:
+			writeUserError(w, err, "Kullanıcı rolü değiştirilemedi")
+		}
+		return
+	}
+
+	utils.WriteJson(w, user, http.StatusOK, "Kullanıcı rolü güncellendi")
+}
+
+func (h *UserHandler) MeGet(w http.ResponseWriter, r *http.Request) {
+	id, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := h.service.GetUserByID(r.Context(), id)
+	if err != nil {
+		writeUserError(w, err, "Profil getirilemedi")
+		return
+	}
+
+	utils.WriteJson(w, user, http.StatusOK, "Profil başarıyla getirildi")
+}
+
+func (h *UserHandler) MePatch(w http.ResponseWriter, r *http.Request) {
+	id, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	h.updateProfile(w, r, id)
+}
+
+func (h *UserHandler) updateProfile(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	var req domain.UpdateProfileRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	user, err := h.service.UpdateProfile(r.Context(), id, &req)
+	if err != nil {
+		writeUserError(w, err, "Profil güncellenemedi")
+		return
+	}
+
+	utils.WriteJson(w, user, http.StatusOK, "Profil başarıyla güncellendi")
+}
+
+// UserDelete kullanıcıyı deleted durumuna alır. Gövde isteğe bağlıdır; gerekçe ve açık
+// atamaların devredileceği kullanıcı verilebilir.
 func (h *UserHandler) UserDelete(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	idStr := vars["id"]
@@ -92,12 +207,115 @@ func (h *UserHandler) UserDelete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.service.DeleteUser(r.Context(), id); err != nil {
-		resp := utils.ErrorResponse("INTERNAL_ERROR", "Kullanıcı silinemedi", err.Error())
-		utils.Return(w, http.StatusInternalServerError, resp)
+	var req domain.DeactivateUserRequest
+	if r.ContentLength != 0 && !h.decode(w, r, &req) {
+		return
+	}
+
+	result, err := h.service.DeleteUser(r.Context(), id, &req)
+	if err != nil {
+		writeLifecycleError(w, err, "Kullanıcı silinemedi")
 		return
 	}
 
-	resp := utils.SuccessResponse(nil, "Kullanıcı başarıyla silindi")
-	utils.Return(w, http.StatusOK, resp)
+	utils.WriteJson(w, result, http.StatusOK, "Kullanıcı başarıyla silindi")
+}
+
+func (h *UserHandler) UserSuspend(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz UUID formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	var req domain.DeactivateUserRequest
+	if r.ContentLength != 0 && !h.decode(w, r, &req) {
+		return
+	}
+
+	result, err := h.service.SuspendUser(r.Context(), id, &req)
+	if err != nil {
+		writeLifecycleError(w, err, "Kullanıcı askıya alınamadı")
+		return
+	}
+
+	utils.WriteJson(w, result, http.StatusOK, "Kullanıcı askıya alındı")
+}
+
+func (h *UserHandler) UserReactivate(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz UUID formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	user, err := h.service.ReactivateUser(r.Context(), id)
+	if err != nil {
+		writeLifecycleError(w, err, "Kullanıcı tekrar aktifleştirilemedi")
+		return
+	}
+
+	utils.WriteJson(w, user, http.StatusOK, "Kullanıcı tekrar aktifleştirildi")
+}
+
+func (h *UserHandler) decode(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz veri formatı", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return false
+	}
+
+	return true
+}
+
+func currentUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(utils.GetUserIDFromContext(r.Context()))
+	if err != nil {
+		resp := utils.ErrorResponse("UNAUTHORIZED", "Giriş yapmanız gerekiyor", err.Error())
+		utils.Return(w, http.StatusUnauthorized, resp)
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+func writeLifecycleError(w http.ResponseWriter, err error, message string) {
+	switch err.(type) {
+	case domain.ErrSelfDeactivation:
+		resp := utils.ErrorResponse("FORBIDDEN", "Kendi hesabınızı devre dışı bırakamazsınız", err.Error())
+		utils.Return(w, http.StatusForbidden, resp)
+	case domain.ErrLastAdmin:
+		resp := utils.ErrorResponse("CONFLICT", "Son aktif yönetici devre dışı bırakılamaz", err.Error())
+		utils.Return(w, http.StatusConflict, resp)
+	case domain.ErrInvalidStatusTransition:
+		resp := utils.ErrorResponse("CONFLICT", "Kullanıcı bu duruma geçirilemez", err.Error())
+		utils.Return(w, http.StatusConflict, resp)
+	case domain.ErrInvalidReassignTarget:
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Atamalar devredilecek kullanıcı geçersiz", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+	default:
+		writeUserError(w, err, message)
+	}
+}
+
+func writeUserError(w http.ResponseWriter, err error, message string) {
+	switch err.(type) {
+	case domain.ErrUserNotFound:
+		resp := utils.ErrorResponse("NOT_FOUND", "Kullanıcı bulunamadı", "")
+		utils.Return(w, http.StatusNotFound, resp)
+		return
+	case domain.ErrManageForbidden:
+		resp := utils.ErrorResponse("FORBIDDEN", "Bu roldeki kullanıcıyı yönetmek için yetkiniz yok", "Gerekli yetki: role:manage")
+		utils.Return(w, http.StatusForbidden, resp)
+		return
+	}
+	resp := utils.ErrorResponse("INTERNAL_ERROR", message, err.Error())
+	utils.Return(w, http.StatusInternalServerError, resp)
 }
@@ -0,0 +1,306 @@
+package http
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+)
+
+const (
+	maxImportBodySize = 5 << 20
+	// bulkRequestTimeout içe/dışa aktarma isteklerinin süresidir. Yeni kullanıcı başına bir
+	// bcrypt hash'i gerektiğinden sunucunun genel 15 sn yazma ve 30 sn istek sınırları yetmez.
+	bulkRequestTimeout = 10 * time.Minute
+	// formulaPrefixes tablolama programlarında hücreyi formül olarak başlatan karakterlerdir.
+	formulaPrefixes = "=+-@\t\r"
+)
+
+// csvColumns export sırasıdır. İçe aktarmada id ve status sütunları yok sayılır, böylece
+// export edilen dosya düzenlenip tekrar içe aktarılabilir.
+var csvColumns = []string{"id", "username", "role", "ad", "soyad", "telefon", "email", "status"}
+
+// UsersImport CSV (text/csv) veya JSON dizisi (application/json) kabul eder.
+// dry_run=true ile hiçbir değişiklik yapılmadan satır sonuçları döner.
+func (h *UserHandler) UsersImport(w http.ResponseWriter, r *http.Request) {
+	r, cancel := extendDeadline(w, r)
+	defer cancel()
+
+	dryRun := false
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz sorgu parametresi", "dry_run true veya false olmalıdır")
+			utils.Return(w, http.StatusBadRequest, resp)
+			return
+		}
+	}
+
+	mediaType := "application/json"
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		mediaType, _, _ = mime.ParseMediaType(ct)
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxImportBodySize)
+	var (
+		rows []domain.ImportUserRow
+		err  error
+	)
+	switch mediaType {
+	case "text/csv":
+		rows, err = parseImportCSV(body)
+	case "application/json":
+		err = json.NewDecoder(body).Decode(&rows)
+	default:
+		resp := utils.ErrorResponse("UNSUPPORTED_MEDIA_TYPE", "Desteklenmeyen dosya türü", "text/csv veya application/json bekleniyor")
+		utils.Return(w, http.StatusUnsupportedMediaType, resp)
+		return
+	}
+	if err != nil {
+		var tooLarge domain.ErrImportTooLarge
+		if errors.As(err, &tooLarge) {
+			resp := utils.ErrorResponse("VALIDATION_ERROR", fmt.Sprintf("En fazla %d satır içe aktarılabilir", tooLarge.Max), err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+			return
+		}
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Dosya okunamadı", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	result, err := h.service.ImportUsers(r.Context(), rows, dryRun)
+	if err != nil {
+		switch e := err.(type) {
+		case domain.ErrEmptyImport:
+			resp := utils.ErrorResponse("VALIDATION_ERROR", "Dosyada içe aktarılacak satır yok", err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+		case domain.ErrImportTooLarge:
+			resp := utils.ErrorResponse("VALIDATION_ERROR", fmt.Sprintf("En fazla %d satır içe aktarılabilir", e.Max), err.Error())
+			utils.Return(w, http.StatusBadRequest, resp)
+		case domain.ErrUsernameTaken:
+			resp := utils.ErrorResponse("CONFLICT", "İçe aktarma sırasında kullanıcılar değişti, tekrar deneyin", err.Error())
+			utils.Return(w, http.StatusConflict, resp)
+		default:
+			resp := utils.ErrorResponse("INTERNAL_ERROR", "Kullanıcılar içe aktarılamadı", err.Error())
+			utils.Return(w, http.StatusInternalServerError, resp)
+		}
+		return
+	}
+
+	if result.Failed > 0 {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Hatalı satırlar var, hiçbir değişiklik uygulanmadı", fmt.Sprintf("%d satır hatalı", result.Failed))
+		resp.Data = result
+		utils.Return(w, http.StatusUnprocessableEntity, resp)
+		return
+	}
+
+	message := "Kullanıcılar içe aktarıldı"
+	if dryRun {
+		message = "Deneme çalıştırması tamamlandı, değişiklik uygulanmadı"
+	}
+	utils.WriteJson(w, result, http.StatusOK, message)
+}
+
+// UsersExport filtreye uyan kullanıcıları format=csv (varsayılan) veya format=json olarak
+// akış halinde indirir. Şifre hash'leri dışa aktarılmaz.
+func (h *UserHandler) UsersExport(w http.ResponseWriter, r *http.Request) {
+	r, cancel := extendDeadline(w, r)
+	defer cancel()
+
+	filter, err := parseUserFilter(r.URL.Query())
+	if err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz sorgu parametresi", err.Error())
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.validate.Struct(filter); err != nil {
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz sorgu parametresi", validation.FormatErr(err))
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "csv"
+	}
+
+	var (
+		started bool
+		write   func(*domain.User) error
+		finish  func() error
+	)
+	begin := func(contentType string) {
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="users.%s"`, format))
+		w.WriteHeader(http.StatusOK)
+		started = true
+	}
+
+	switch format {
+	case "csv":
+		cw := csv.NewWriter(w)
+		write = func(user *domain.User) error {
+			if !started {
+				begin("text/csv; charset=utf-8")
+				if err := cw.Write(csvColumns); err != nil {
+					return err
+				}
+			}
+			return cw.Write([]string{
+				user.Id.String(),
+				escapeCSVCell(user.Username),
+				user.Role,
+				escapeCSVCell(user.Ad),
+				escapeCSVCell(user.Soyad),
+				user.Telefon,
+				escapeCSVCell(user.Email),
+				user.Status,
+			})
+		}
+		finish = func() error {
+			if !started {
+				begin("text/csv; charset=utf-8")
+				cw.Write(csvColumns)
+			}
+			cw.Flush()
+			return cw.Error()
+		}
+	case "json":
+		enc := json.NewEncoder(w)
+		write = func(user *domain.User) error {
+			sep := ","
+			if !started {
+				begin("application/json")
+				sep = "["
+			}
+			if _, err := io.WriteString(w, sep); err != nil {
+				return err
+			}
+			return enc.Encode(user)
+		}
+		finish = func() error {
+			if !started {
+				begin("application/json")
+				_, err := io.WriteString(w, "[]\n")
+				return err
+			}
+			_, err := io.WriteString(w, "]\n")
+			return err
+		}
+	default:
+		resp := utils.ErrorResponse("VALIDATION_ERROR", "Geçersiz sorgu parametresi", "format csv veya json olmalıdır")
+		utils.Return(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	if err := h.service.ExportUsers(r.Context(), filter, write); err != nil {
+		// Yanıt başladıktan sonra durum kodu değiştirilemez; istemci yarım dosya alır.
+		if !started {
+			resp := utils.ErrorResponse("INTERNAL_ERROR", "Kullanıcılar dışa aktarılamadı", err.Error())
+			utils.Return(w, http.StatusInternalServerError, resp)
+		}
+		return
+	}
+	finish()
+}
+
+// extendDeadline bağlantının okuma/yazma deadline'larını ve istek context'inin süresini
+// bulkRequestTimeout'a uzatır. İçe aktarma transaction'ı istemci bağlantıyı kapatsa da
+// yarıda kesilmez.
+func extendDeadline(w http.ResponseWriter, r *http.Request) (*http.Request, context.CancelFunc) {
+	deadline := time.Now().Add(bulkRequestTimeout)
+	rc := http.NewResponseController(w)
+	rc.SetReadDeadline(deadline)
+	rc.SetWriteDeadline(deadline)
+
+	ctx, cancel := context.WithDeadline(context.WithoutCancel(r.Context()), deadline)
+	return r.WithContext(ctx), cancel
+}
+
+// parseImportCSV başlık satırındaki sütun adlarına göre satırları okur. username ve role
+// sütunları zorunludur, tanınmayan sütunlar yok sayılır.
+func parseImportCSV(r io.Reader) ([]domain.ImportUserRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"username", "role"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%s sütunu eksik", required)
+		}
+	}
+
+	var rows []domain.ImportUserRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == domain.MaxImportRows {
+			return nil, domain.ErrImportTooLarge{Max: domain.MaxImportRows}
+		}
+
+		get := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return unescapeCSVCell(strings.TrimSpace(record[i]))
+		}
+		rows = append(rows, domain.ImportUserRow{
+			Username: get("username"),
+			Password: get("password"),
+			Role:     get("role"),
+			Ad:       get("ad"),
+			Soyad:    get("soyad"),
+			Telefon:  get("telefon"),
+			Email:    get("email"),
+		})
+	}
+	return rows, nil
+}
+
+// escapeCSVCell tablolama programlarının formül olarak çalıştıracağı hücrelerin başına
+// tek tırnak ekler (CSV injection). unescapeCSVCell içe aktarmada bunu geri alır.
+func escapeCSVCell(value string) string {
+	if value != "" && strings.ContainsRune(formulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func unescapeCSVCell(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(value[1])) {
+		return value[1:]
+	}
+	return value
+}
@@ -0,0 +1,39 @@
+package http
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+)
+
+// parseUserFilter GET /api/users query string'ini domain.UserFilter'a çevirir.
+// Uzunluk ve limit kontrolleri validator'a bırakılır.
+func parseUserFilter(q url.Values) (*domain.UserFilter, error) {
+	filter := &domain.UserFilter{
+		Query:  strings.TrimSpace(q.Get("q")),
+		Role:   strings.TrimSpace(q.Get("role")),
+		Status: strings.TrimSpace(q.Get("status")),
+		Cursor: q.Get("cursor"),
+	}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("limit sayı olmalıdır")
+		}
+		filter.Limit = limit
+	}
+
+	if v := q.Get("include_total"); v != "" {
+		includeTotal, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("include_total true veya false olmalıdır")
+		}
+		filter.IncludeTotal = includeTotal
+	}
+
+	return filter, nil
+}
@@ -1,9 +1,15 @@
 package repository
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type PostgresUserRepository struct {
@@ -14,42 +20,237 @@ func NewPostgresRepository(db *sqlx.DB) domain.UserRepository {
 	return &PostgresUserRepository{db: db}
 }
 
-func (r *PostgresUserRepository) GetAll() ([]domain.User, error) {
+const userColumns = `id, username, '' as password, role, COALESCE(ad, '') as ad, COALESCE(soyad, '') as soyad, COALESCE(telefon, '') as telefon, COALESCE(email, '') as email, ` + statusColumns
+
+const statusColumns = `status, COALESCE(status_reason, '') as status_reason, deleted_at`
+
+func (r *PostgresUserRepository) List(ctx context.Context, filter *domain.UserFilter) ([]domain.User, error) {
+	where, args := buildUserWhere(filter)
+
+	if filter.After != nil {
+		args = append(args, filter.After.Username, filter.After.ID)
+		where = append(where, fmt.Sprintf("(username, id) > ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	query := `SELECT ` + userColumns + ` FROM users WHERE ` + strings.Join(where, " AND ")
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf(" ORDER BY username ASC, id ASC LIMIT $%d", len(args))
+
 	users := []domain.User{}
-	query := `SELECT id, username, '' as password, role, COALESCE(ad, '') as ad, COALESCE(soyad, '') as soyad, COALESCE(telefon, '') as telefon, COALESCE(email, '') as email FROM users ORDER BY id ASC`
-	err := r.db.Select(&users, query)
-	if err != nil {
+	if err := r.db.SelectContext(ctx, &users, query, args...); err != nil {
 		return nil, err
 	}
 	return users, nil
 }
 
-func (r *PostgresUserRepository) GetByUsername(username string) (*domain.User, error) {
+func (r *PostgresUserRepository) Count(ctx context.Context, filter *domain.UserFilter) (int, error) {
+	where, args := buildUserWhere(filter)
+
+	var total int
+	err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM users WHERE `+strings.Join(where, " AND "), args...)
+	return total, err
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// buildUserWhere filtre alanlarını cursor hariç WHERE koşullarına çevirir. q kullanıcı adı,
+// ad, soyad ve email içinde büyük/küçük harf duyarsız aranır.
+func buildUserWhere(filter *domain.UserFilter) ([]string, []interface{}) {
+	where := []string{"TRUE"}
+	args := []interface{}{}
+
+	if filter.Query != "" {
+		args = append(args, "%"+likeEscaper.Replace(filter.Query)+"%")
+		n := len(args)
+		where = append(where, fmt.Sprintf(`(username ILIKE $%d ESCAPE '\' OR ad ILIKE $%d ESCAPE '\' OR soyad ILIKE $%d ESCAPE '\' OR email ILIKE $%d ESCAPE '\')`, n, n, n, n))
+	}
+	if filter.Role != "" {
+		args = append(args, filter.Role)
+		where = append(where, fmt.Sprintf("role = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where = append(where, fmt.Sprintf("status = $%d", len(args)))
+	} else {
+		args = append(args, domain.StatusDeleted)
+		where = append(where, fmt.Sprintf("status <> $%d", len(args)))
+	}
+
+	return where, args
+}
+
+func (r *PostgresUserRepository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
 	user := &domain.User{}
-	query := `SELECT id, username, password, role, COALESCE(ad, '') as ad, COALESCE(soyad, '') as soyad, COALESCE(telefon, '') as telefon, COALESCE(email, '') as email FROM users WHERE username = $1`
-	if err := r.db.Get(user, query, username); err != nil {
+	query := `SELECT id, username, password, role, COALESCE(ad, '') as ad, COALESCE(soyad, '') as soyad, COALESCE(telefon, '') as telefon, COALESCE(email, '') as email, ` + statusColumns + ` FROM users WHERE username = $1`
+	if err := r.db.GetContext(ctx, user, query, username); err != nil {
 		return nil, err
 	}
 	return user, nil
 }
 
-func (r *PostgresUserRepository) GetByUserID(userID uuid.UUID) (*domain.User, error) {
+func (r *PostgresUserRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
 	user := &domain.User{}
-	query := `SELECT id, username, COALESCE(ad, '') as ad, COALESCE(soyad, '') as soyad, COALESCE(email, '') as email FROM users WHERE id = $1`
-	if err := r.db.Get(user, query, userID); err != nil {
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
+	if err := r.db.GetContext(ctx, user, query, userID); err != nil {
 		return nil, err
 	}
 	return user, nil
 }
 
-func (r *PostgresUserRepository) Create(user *domain.User) error {
-	query := `INSERT INTO users (username, password, role, ad, soyad, telefon, email) VALUES (:username, :password, :role, :ad, :soyad, :telefon, :email)`
-	_, err := r.db.NamedExec(query, user)
-	return err
+func (r *PostgresUserRepository) GetByUsernames(ctx context.Context, usernames []string) ([]domain.User, error) {
+	users := []domain.User{}
+	query := `SELECT ` + userColumns + ` FROM users WHERE username = ANY($1)`
+	if err := r.db.SelectContext(ctx, &users, query, pq.Array(usernames)); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (r *PostgresUserRepository) ExistingRoles(ctx context.Context, roles []string) (map[string]bool, error) {
+	var names []string
+	if err := r.db.SelectContext(ctx, &names, `SELECT name FROM roles WHERE name = ANY($1)`, pq.Array(roles)); err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool, len(names))
+	for _, name := range names {
+		existing[name] = true
+	}
+	return existing, nil
+}
+
+func (r *PostgresUserRepository) CountByRole(ctx context.Context, role string) (int, error) {
+	var count int
+	if err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM users WHERE role = $1 AND status = $2`, role, domain.StatusActive); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *PostgresUserRepository) GetStatus(ctx context.Context, userID uuid.UUID) (string, error) {
+	var status string
+	if err := r.db.GetContext(ctx, &status, `SELECT status FROM users WHERE id = $1`, userID); err != nil {
+		return "", err
+	}
+	return status, nil
+}
+
+func (r *PostgresUserRepository) Create(ctx context.Context, user *domain.User) error {
+	return r.CreateTx(ctx, nil, user)
+}
+
+func (r *PostgresUserRepository) CreateTx(ctx context.Context, tx *sqlx.Tx, user *domain.User) error {
+	query := `
+		INSERT INTO users (username, password, role, ad, soyad, telefon, email)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	err := executor.QueryRowxContext(ctx, query,
+		user.Username, user.Password, user.Role, user.Ad, user.Soyad, user.Telefon, user.Email,
+	).Scan(&user.Id)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			switch pqErr.Code {
+			case "23503":
+				return domain.ErrUnknownRole{}
+			case "23505":
+				return domain.ErrUsernameTaken{}
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresUserRepository) UpdateProfile(ctx context.Context, tx *sqlx.Tx, user *domain.User) error {
+	query := `UPDATE users SET ad = $2, soyad = $3, telefon = $4, email = $5 WHERE id = $1`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	res, err := executor.ExecContext(ctx, query, user.Id, user.Ad, user.Soyad, user.Telefon, user.Email)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrUserNotFound{}
+	}
+	return nil
+}
+
+func (r *PostgresUserRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status, reason string) error {
+	query := `
+		UPDATE users
+		SET status = $2,
+			status_reason = NULLIF($3, ''),
+			status_changed_at = NOW(),
+			deleted_at = CASE WHEN $2 = 'deleted' THEN NOW() ELSE NULL END
+		WHERE id = $1
+	`
+	res, err := r.db.ExecContext(ctx, query, id, status, reason)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrUserNotFound{}
+	}
+	return nil
+}
+
+func (r *PostgresUserRepository) UpdatePassword(ctx context.Context, tx *sqlx.Tx, id uuid.UUID, passwordHash string) error {
+	query := `UPDATE users SET password = $2, password_changed_at = NOW() WHERE id = $1`
+
+	var executor sqlx.ExtContext = r.db
+	if tx != nil {
+		executor = tx
+	}
+
+	res, err := executor.ExecContext(ctx, query, id, passwordHash)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrUserNotFound{}
+	}
+	return nil
 }
 
-func (r *PostgresUserRepository) Delete(id uuid.UUID) error {
-	query := `DELETE FROM users WHERE id = $1`
-	_, err := r.db.Exec(query, id)
-	return err
+func (r *PostgresUserRepository) UpdateRole(ctx context.Context, id uuid.UUID, role string) error {
+	res, err := r.db.ExecContext(ctx, `UPDATE users SET role = $2 WHERE id = $1`, id, role)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return domain.ErrUnknownRole{}
+		}
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrUserNotFound{}
+	}
+	return nil
 }
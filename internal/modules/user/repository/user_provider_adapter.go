@@ -1,6 +1,10 @@
 package repository
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+
 	"github.com/M1ralai/go-modular-monolith-template/internal/modules/task/domain"
 	userDomain "github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
 	"github.com/google/uuid"
@@ -16,9 +20,25 @@ func NewUserProviderAdapter(userRepo userDomain.UserRepository) domain.UserProvi
 	}
 }
 
-func (a *UserProviderAdapter) GetUserByID(userID uuid.UUID) (*domain.UserInfo, error) {
-	user, err := a.userRepo.GetByUserID(userID)
+func (a *UserProviderAdapter) GetUserByID(ctx context.Context, userID uuid.UUID) (*domain.UserInfo, error) {
+	user, err := a.userRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.UserInfo{
+		ID:       user.Id,
+		Username: user.Username,
+		Email:    user.Email,
+	}, nil
+}
+
+func (a *UserProviderAdapter) GetUserByUsername(ctx context.Context, username string) (*domain.UserInfo, error) {
+	user, err := a.userRepo.GetByUsername(ctx, username)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
 		return nil, err
 	}
 
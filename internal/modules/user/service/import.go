@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"runtime"
+	"strconv"
+	"sync"
+
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/common/validation"
+	"github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const exportBatchSize = 500
+
+// importPlan doğrulanmış bir satırın uygulanacak değişikliğidir.
+type importPlan struct {
+	row  domain.ImportUserRow
+	user *domain.User
+}
+
+// ImportUsers satırları kullanıcı adına göre upsert eder: yeni kullanıcılar oluşturulur,
+// mevcut kullanıcıların sadece profil alanları güncellenir. Tüm satırlar önce doğrulanır;
+// hatalı satır varsa veya dryRun istenmişse hiçbir değişiklik yapılmaz. Değişiklikler tek
+// transaction içinde uygulanır, aynı dosya tekrar içe aktarıldığında satırlar unchanged döner.
+func (s *userService) ImportUsers(ctx context.Context, rows []domain.ImportUserRow, dryRun bool) (*domain.ImportResult, error) {
+	if len(rows) == 0 {
+		return nil, domain.ErrEmptyImport{}
+	}
+	if len(rows) > domain.MaxImportRows {
+		return nil, domain.ErrImportTooLarge{Max: domain.MaxImportRows}
+	}
+
+	usernames := make([]string, 0, len(rows))
+	roles := make([]string, 0, len(rows))
+	for _, row := range rows {
+		usernames = append(usernames, row.Username)
+		roles = append(roles, row.Role)
+	}
+
+	existingUsers, err := s.repo.GetByUsernames(ctx, usernames)
+	if err != nil {
+		s.logger.Error("Failed to get users for import", err, nil)
+		return nil, err
+	}
+	existing := make(map[string]*domain.User, len(existingUsers))
+	for i := range existingUsers {
+		existing[existingUsers[i].Username] = &existingUsers[i]
+	}
+
+	knownRoles, err := s.repo.ExistingRoles(ctx, roles)
+	if err != nil {
+		s.logger.Error("Failed to get roles for import", err, nil)
+		return nil, err
+	}
+
+	canGrantRoles, err := s.canGrantRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.ImportResult{DryRun: dryRun, Rows: make([]domain.ImportRowResult, 0, len(rows))}
+	var creates, updates []importPlan
+	seen := make(map[string]int, len(rows))
+
+	for i, row := range rows {
+		res := domain.ImportRowResult{Row: i + 1, Username: row.Username}
+
+		var (
+			plan   importPlan
+			action string
+			msg    string
+		)
+		if err := validation.Get().Struct(row); err != nil {
+			msg = validation.FormatErr(err)
+		} else if first, ok := seen[row.Username]; ok {
+			msg = "Kullanıcı adı dosyada tekrar ediyor (satır " + strconv.Itoa(first) + ")"
+		} else {
+			plan, action, msg = planImportRow(row, existing[row.Username], knownRoles, canGrantRoles)
+		}
+		if _, ok := seen[row.Username]; !ok {
+			seen[row.Username] = i + 1
+		}
+
+		if msg != "" {
+			res.Action, res.Error = domain.ImportFailed, msg
+			result.Failed++
+		} else {
+			res.Action = action
+			switch action {
+			case domain.ImportCreated:
+				result.Created++
+				creates = append(creates, plan)
+			case domain.ImportUpdated:
+				result.Updated++
+				updates = append(updates, plan)
+			default:
+				result.Unchanged++
+			}
+		}
+		result.Rows = append(result.Rows, res)
+	}
+
+	if dryRun || result.Failed > 0 || len(creates)+len(updates) == 0 {
+		return result, nil
+	}
+
+	if err := hashImportPasswords(creates); err != nil {
+		s.logger.Error("Failed to hash password", err, nil)
+		return nil, err
+	}
+
+	err = s.uow.Do(ctx, func(tx *sqlx.Tx) error {
+		for _, plan := range creates {
+			if err := s.repo.CreateTx(ctx, tx, plan.user); err != nil {
+				return err
+			}
+		}
+		for _, plan := range updates {
+			if err := s.repo.UpdateProfile(ctx, tx, plan.user); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		switch err.(type) {
+		case domain.ErrUsernameTaken, domain.ErrUnknownRole, domain.ErrUserNotFound:
+		default:
+			s.logger.Error("Failed to import users", err, nil)
+		}
+		return nil, err
+	}
+
+	result.Applied = true
+	s.logger.Info("Users imported", map[string]interface{}{
+		"action":    "USER_IMPORT",
+		"actor":     utils.GetUsernameFromContext(ctx),
+		"created":   result.Created,
+		"updated":   result.Updated,
+		"unchanged": result.Unchanged,
+	})
+
+	return result, nil
+}
+
+// planImportRow satırın mevcut kayıtla karşılaştırılmasını yapar; hata mesajı boş değilse
+// satır uygulanamaz. canGrantRoles false ise yeni kullanıcılara sadece varsayılan rol verilebilir
+// ve varsayılan rol dışındaki mevcut kullanıcılar güncellenemez.
+func planImportRow(row domain.ImportUserRow, current *domain.User, knownRoles map[string]bool, canGrantRoles bool) (importPlan, string, string) {
+	if current == nil {
+		if row.Password == "" {
+			return importPlan{}, "", "Yeni kullanıcılar için şifre zorunludur"
+		}
+		if !knownRoles[row.Role] {
+			return importPlan{}, "", "Rol tanımlı değil: " + row.Role
+		}
+		if row.Role != defaultRole && !canGrantRoles {
+			return importPlan{}, "", "Bu rolü atamak için role:manage yetkisi gerekir: " + row.Role
+		}
+		return importPlan{row: row, user: &domain.User{
+			Username: row.Username,
+			Role:     row.Role,
+			Ad:       row.Ad,
+			Soyad:    row.Soyad,
+			Telefon:  row.Telefon,
+			Email:    row.Email,
+		}}, domain.ImportCreated, ""
+	}
+
+	if current.Status == domain.StatusDeleted {
+		return importPlan{}, "", "Kullanıcı silinmiş; kullanıcı adı tekrar kullanılamaz"
+	}
+	if current.Role != row.Role {
+		return importPlan{}, "", "Mevcut kullanıcının rolü içe aktarma ile değiştirilemez (mevcut rol: " + current.Role + ")"
+	}
+
+	if current.Ad == row.Ad && current.Soyad == row.Soyad && current.Telefon == row.Telefon && current.Email == row.Email {
+		return importPlan{}, domain.ImportUnchanged, ""
+	}
+	if current.Role != defaultRole && !canGrantRoles {
+		return importPlan{}, "", "Bu roldeki kullanıcıyı güncellemek için role:manage yetkisi gerekir: " + current.Role
+	}
+
+	updated := *current
+	updated.Ad, updated.Soyad, updated.Telefon, updated.Email = row.Ad, row.Soyad, row.Telefon, row.Email
+	return importPlan{row: row, user: &updated}, domain.ImportUpdated, ""
+}
+
+// hashImportPasswords bcrypt maliyeti yüksek olduğundan şifreleri CPU sayısı kadar
+// goroutine ile paralel hash'ler.
+func hashImportPasswords(plans []importPlan) error {
+	jobs := make(chan int)
+	errs := make(chan error, len(plans))
+	var wg sync.WaitGroup
+
+	workers := runtime.NumCPU()
+	if workers > len(plans) {
+		workers = len(plans)
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				hash, err := bcrypt.GenerateFromPassword([]byte(plans[i].row.Password), bcryptCost)
+				if err != nil {
+					errs <- err
+					continue
+				}
+				plans[i].user.Password = string(hash)
+			}
+		}()
+	}
+
+	for i := range plans {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	close(errs)
+
+	return <-errs
+}
+
+// ExportUsers filtreye uyan kullanıcıları keyset sayfaları halinde okuyup write'a verir;
+// tüm liste belleğe alınmaz. Şifre hash'leri hiçbir zaman okunmaz.
+func (s *userService) ExportUsers(ctx context.Context, filter *domain.UserFilter, write func(*domain.User) error) error {
+	filter.Limit = exportBatchSize
+	filter.After = nil
+
+	exported := 0
+	for {
+		users, err := s.repo.List(ctx, filter)
+		if err != nil {
+			s.logger.Error("Failed to list users for export", err, nil)
+			return err
+		}
+
+		for i := range users {
+			if err := write(&users[i]); err != nil {
+				return err
+			}
+		}
+		exported += len(users)
+
+		if len(users) < filter.Limit {
+			break
+		}
+		last := users[len(users)-1]
+		filter.After = &domain.UserCursor{Username: last.Username, ID: last.Id}
+	}
+
+	s.logger.Info("Users exported", map[string]interface{}{
+		"action": "USER_EXPORT",
+		"actor":  utils.GetUsernameFromContext(ctx),
+		"count":  exported,
+	})
+
+	return nil
+}
@@ -2,51 +2,110 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"slices"
+	"time"
 
 	"github.com/M1ralai/go-modular-monolith-template/internal/common/utils"
+	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/database"
 	"github.com/M1ralai/go-modular-monolith-template/internal/infrastructure/logger"
 	"github.com/M1ralai/go-modular-monolith-template/internal/modules/user/domain"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	adminRole  = "ADMIN"
+	bcryptCost = 14
+
+	// defaultRole user:manage yetkisiyle verilebilen tek roldür; diğer roller için
+	// roleManagePermission gerekir.
+	defaultRole          = "USER"
+	roleManagePermission = "role:manage"
+)
+
 type UserService interface {
-	ListUsers() ([]domain.User, error)
+	ListUsers(ctx context.Context, filter *domain.UserFilter) (*domain.UserPage, error)
 	CreateUser(ctx context.Context, req *domain.CreateUserRequest) (*domain.User, error)
-	DeleteUser(ctx context.Context, id uuid.UUID) error
+	SuspendUser(ctx context.Context, id uuid.UUID, req *domain.DeactivateUserRequest) (*domain.DeactivateUserResponse, error)
+	ReactivateUser(ctx context.Context, id uuid.UUID) (*domain.User, error)
+	DeleteUser(ctx context.Context, id uuid.UUID, req *domain.DeactivateUserRequest) (*domain.DeactivateUserResponse, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
+	ImportUsers(ctx context.Context, rows []domain.ImportUserRow, dryRun bool) (*domain.ImportResult, error)
+	ExportUsers(ctx context.Context, filter *domain.UserFilter, write func(*domain.User) error) error
+	UpdateProfile(ctx context.Context, id uuid.UUID, req *domain.UpdateProfileRequest) (*domain.User, error)
+	ChangeRole(ctx context.Context, id uuid.UUID, req *domain.ChangeRoleRequest) (*domain.User, error)
+	CheckManageable(ctx context.Context, id uuid.UUID) error
 }
 
 type userService struct {
-	repo   domain.UserRepository
-	logger logger.Logger
+	repo        domain.UserRepository
+	reassigner  domain.TaskReassigner
+	permissions domain.PermissionChecker
+	uow         database.UnitOfWork
+	logger      logger.Logger
 }
 
-func NewService(repo domain.UserRepository, logger logger.Logger) UserService {
+func NewService(repo domain.UserRepository, reassigner domain.TaskReassigner, permissions domain.PermissionChecker, uow database.UnitOfWork, logger logger.Logger) UserService {
 	return &userService{
-		repo:   repo,
-		logger: logger,
+		repo:        repo,
+		reassigner:  reassigner,
+		permissions: permissions,
+		uow:         uow,
+		logger:      logger,
 	}
 }
 
-func (s *userService) ListUsers() ([]domain.User, error) {
-	users, err := s.repo.GetAll()
+func (s *userService) ListUsers(ctx context.Context, filter *domain.UserFilter) (*domain.UserPage, error) {
+	filter.Normalize()
+
+	if filter.Cursor != "" {
+		cursor, err := domain.DecodeUserCursor(filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = cursor
+	}
+
+	pageSize := filter.Limit
+	filter.Limit = pageSize + 1
+	users, err := s.repo.List(ctx, filter)
+	filter.Limit = pageSize
 	if err != nil {
 		s.logger.Error("Failed to list users", err, nil)
 		return nil, err
 	}
 
+	page := &domain.UserPage{Items: users}
+	if len(users) > pageSize {
+		page.Items = users[:pageSize]
+		last := page.Items[pageSize-1]
+		page.NextCursor = (&domain.UserCursor{Username: last.Username, ID: last.Id}).Encode()
+	}
+
+	if filter.IncludeTotal {
+		total, err := s.repo.Count(ctx, filter)
+		if err != nil {
+			s.logger.Error("Failed to count users", err, nil)
+			return nil, err
+		}
+		page.Total = &total
+	}
+
 	s.logger.Info("Users listed", map[string]interface{}{
 		"action": "USER_LIST",
-		"count":  len(users),
+		"count":  len(page.Items),
 	})
 
-	return users, nil
+	return page, nil
 }
 
 func (s *userService) CreateUser(ctx context.Context, req *domain.CreateUserRequest) (*domain.User, error) {
+	if err := s.checkRoleGrant(ctx, req.Role); err != nil {
+		return nil, err
+	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), 14)
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcryptCost)
 	if err != nil {
 		s.logger.Error("Failed to hash password", err, nil)
 		return nil, err
@@ -62,11 +121,15 @@ func (s *userService) CreateUser(ctx context.Context, req *domain.CreateUserRequ
 		Email:    req.Email,
 	}
 
-	err = s.repo.Create(user)
+	err = s.repo.Create(ctx, user)
 	if err != nil {
-		s.logger.Error("Failed to create user", err, map[string]interface{}{
-			"username": req.Username,
-		})
+		switch err.(type) {
+		case domain.ErrUsernameTaken, domain.ErrUnknownRole:
+		default:
+			s.logger.Error("Failed to create user", err, map[string]interface{}{
+				"username": req.Username,
+			})
+		}
 		return nil, err
 	}
 
@@ -82,29 +145,144 @@ func (s *userService) CreateUser(ctx context.Context, req *domain.CreateUserRequ
 	return user, nil
 }
 
-func (s *userService) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	err := s.repo.Delete(id)
+// SuspendUser kullanıcının giriş yapmasını ve mevcut token'larını kullanmasını engeller;
+// hesap ReactivateUser ile tekrar açılabilir.
+func (s *userService) SuspendUser(ctx context.Context, id uuid.UUID, req *domain.DeactivateUserRequest) (*domain.DeactivateUserResponse, error) {
+	return s.deactivate(ctx, id, domain.StatusSuspended, req)
+}
+
+// DeleteUser kullanıcıyı fiziksel olarak silmez, deleted durumuna alır. Task, atama ve
+// aktivite kayıtları kullanıcıya referans vermeye devam eder; kullanıcı adı tekrar
+// kullanılamaz. Silme geri alınamaz.
+func (s *userService) DeleteUser(ctx context.Context, id uuid.UUID, req *domain.DeactivateUserRequest) (*domain.DeactivateUserResponse, error) {
+	return s.deactivate(ctx, id, domain.StatusDeleted, req)
+}
+
+// deactivate atamaları durum değişikliğinden önce devreder; durum güncellemesi başarısız
+// olursa istek tekrarlanabilir, devredilecek açık atama kalmamış olur.
+func (s *userService) deactivate(ctx context.Context, id uuid.UUID, status string, req *domain.DeactivateUserRequest) (*domain.DeactivateUserResponse, error) {
+	if id.String() == utils.GetUserIDFromContext(ctx) {
+		return nil, domain.ErrSelfDeactivation{}
+	}
+
+	user, err := s.getUser(ctx, id)
 	if err != nil {
-		s.logger.Error("Failed to delete user", err, map[string]interface{}{"user_id": id.String()})
-		return err
+		return nil, err
+	}
+	if err := s.checkManage(ctx, user); err != nil {
+		return nil, err
+	}
+	if user.Status == domain.StatusDeleted || user.Status == status {
+		return nil, domain.ErrInvalidStatusTransition{From: user.Status, To: status}
 	}
 
-	actorUsername := utils.GetUsernameFromContext(ctx)
-	s.logger.Info("User deleted", map[string]interface{}{
-		"action":  "USER_DELETE",
-		"actor":   actorUsername,
-		"user_id": id.String(),
+	if user.Role == adminRole && user.IsActive() {
+		if err := s.ensureOtherAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &domain.DeactivateUserResponse{User: user}
+	if req.ReassignTo != "" {
+		targetID := uuid.MustParse(req.ReassignTo)
+		if targetID == id {
+			return nil, domain.ErrInvalidReassignTarget{}
+		}
+		target, err := s.getUser(ctx, targetID)
+		if err != nil {
+			if _, ok := err.(domain.ErrUserNotFound); ok {
+				return nil, domain.ErrInvalidReassignTarget{}
+			}
+			return nil, err
+		}
+		if !target.IsActive() {
+			return nil, domain.ErrInvalidReassignTarget{}
+		}
+
+		resp.ReassignedTaskCount, err = s.reassigner.ReassignOpenAssignments(ctx, id, targetID)
+		if err != nil {
+			s.logger.Error("Failed to reassign user tasks", err, map[string]interface{}{
+				"user_id":     id.String(),
+				"reassign_to": targetID.String(),
+			})
+			return nil, err
+		}
+	}
+
+	if err := s.repo.UpdateStatus(ctx, id, status, req.Reason); err != nil {
+		if _, ok := err.(domain.ErrUserNotFound); !ok {
+			s.logger.Error("Failed to update user status", err, map[string]interface{}{
+				"user_id": id.String(),
+				"status":  status,
+			})
+		}
+		return nil, err
+	}
+
+	action, message := "USER_SUSPEND", "User suspended"
+	if status == domain.StatusDeleted {
+		action, message = "USER_DELETE", "User deleted"
+	}
+	s.logger.Info(message, map[string]interface{}{
+		"action":           action,
+		"actor":            utils.GetUsernameFromContext(ctx),
+		"user_id":          id.String(),
+		"username":         user.Username,
+		"reason":           req.Reason,
+		"reassign_to":      req.ReassignTo,
+		"reassigned_tasks": resp.ReassignedTaskCount,
 	})
 
-	return nil
+	user.Status = status
+	user.StatusReason = req.Reason
+	if status == domain.StatusDeleted {
+		now := time.Now()
+		user.DeletedAt = &now
+	}
+	return resp, nil
+}
+
+// ReactivateUser askıya alınmış bir hesabı tekrar açar. Silinmiş hesaplar geri açılamaz.
+func (s *userService) ReactivateUser(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	user, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkManage(ctx, user); err != nil {
+		return nil, err
+	}
+	if user.IsActive() {
+		return user, nil
+	}
+	if user.Status != domain.StatusSuspended {
+		return nil, domain.ErrInvalidStatusTransition{From: user.Status, To: domain.StatusActive}
+	}
+
+	if err := s.repo.UpdateStatus(ctx, id, domain.StatusActive, ""); err != nil {
+		if _, ok := err.(domain.ErrUserNotFound); !ok {
+			s.logger.Error("Failed to update user status", err, map[string]interface{}{
+				"user_id": id.String(),
+				"status":  domain.StatusActive,
+			})
+		}
+		return nil, err
+	}
+
+	s.logger.Info("User reactivated", map[string]interface{}{
+		"action":   "USER_REACTIVATE",
+		"actor":    utils.GetUsernameFromContext(ctx),
+		"user_id":  id.String(),
+		"username": user.Username,
+	})
+
+	user.Status = domain.StatusActive
+	user.StatusReason = ""
+	return user, nil
 }
 
 func (s *userService) GetUserByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
-	user, err := s.repo.GetByUserID(id)
+	user, err := s.getUser(ctx, id)
 	if err != nil {
-		s.logger.Error("Failed to get user by ID", err, map[string]interface{}{
-			"user_id": id.String(),
-		})
 		return nil, err
 	}
 
@@ -116,3 +294,201 @@ func (s *userService) GetUserByID(ctx context.Context, id uuid.UUID) (*domain.Us
 
 	return user, nil
 }
+
+// UpdateProfile sadece profil alanlarını değiştirir; kullanıcı adı, rol ve şifre ayrı
+// akışlarla güncellenir.
+func (s *userService) UpdateProfile(ctx context.Context, id uuid.UUID, req *domain.UpdateProfileRequest) (*domain.User, error) {
+	user, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string]interface{}{}
+	apply := func(field string, target *string, value *string) {
+		if value != nil && *value != *target {
+			changes[field] = *value
+			*target = *value
+		}
+	}
+	apply("ad", &user.Ad, req.Ad)
+	apply("soyad", &user.Soyad, req.Soyad)
+	apply("telefon", &user.Telefon, req.Telefon)
+	apply("email", &user.Email, req.Email)
+
+	if len(changes) == 0 {
+		return user, nil
+	}
+	// E-posta şifre sıfırlama bağlantısının gittiği adrestir; yetkili bir hesabın e-postasını
+	// değiştirmek hesabı ele geçirmeye yeter.
+	if _, ok := changes["email"]; ok {
+		if err := s.checkManage(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.UpdateProfile(ctx, nil, user); err != nil {
+		if _, ok := err.(domain.ErrUserNotFound); !ok {
+			s.logger.Error("Failed to update user profile", err, map[string]interface{}{
+				"user_id": id.String(),
+			})
+		}
+		return nil, err
+	}
+
+	s.logger.Info("User profile updated", map[string]interface{}{
+		"action":  "USER_UPDATE",
+		"actor":   utils.GetUsernameFromContext(ctx),
+		"user_id": id.String(),
+		"changes": changes,
+	})
+
+	return user, nil
+}
+
+// ChangeRole kullanıcının rolünü değiştirir. Yeni rol kullanıcının bir sonraki token
+// yenilemesinde (en geç access token süresi dolduğunda) geçerli olur. Kullanıcılar kendi
+// rollerini değiştiremez ve son aktif ADMIN'in rolü değiştirilemez; aksi halde sistem yöneticisiz
+// kalabilirdi.
+func (s *userService) ChangeRole(ctx context.Context, id uuid.UUID, req *domain.ChangeRoleRequest) (*domain.User, error) {
+	if id.String() == utils.GetUserIDFromContext(ctx) {
+		return nil, domain.ErrSelfRoleChange{}
+	}
+
+	user, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == req.Role {
+		return user, nil
+	}
+
+	if user.Role == adminRole && user.IsActive() {
+		if err := s.ensureOtherAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.UpdateRole(ctx, id, req.Role); err != nil {
+		switch err.(type) {
+		case domain.ErrUnknownRole, domain.ErrUserNotFound:
+		default:
+			s.logger.Error("Failed to change user role", err, map[string]interface{}{
+				"user_id": id.String(),
+			})
+		}
+		return nil, err
+	}
+
+	s.logger.Info("User role changed", map[string]interface{}{
+		"action":   "USER_ROLE_CHANGE",
+		"actor":    utils.GetUsernameFromContext(ctx),
+		"user_id":  id.String(),
+		"old_role": user.Role,
+		"new_role": req.Role,
+	})
+
+	user.Role = req.Role
+	return user, nil
+}
+
+// checkRoleGrant varsayılan rol dışındaki rollerin sadece role:manage yetkisi olan
+// kullanıcılar tarafından verilmesini sağlar. Aksi halde user:manage yetkisi ADMIN
+// kullanıcısı oluşturmaya yeterdi.
+func (s *userService) checkRoleGrant(ctx context.Context, role string) error {
+	if role == defaultRole {
+		return nil
+	}
+
+	allowed, err := s.canGrantRoles(ctx)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		s.logger.Info("Role grant rejected", map[string]interface{}{
+			"action": "USER_ROLE_GRANT_REJECTED",
+			"actor":  utils.GetUsernameFromContext(ctx),
+			"role":   role,
+		})
+		return domain.ErrRoleGrantForbidden{Role: role}
+	}
+	return nil
+}
+
+// canGrantRoles isteği yapan kullanıcının varsayılan rol dışındaki rolleri verip
+// veremeyeceğini döner; kişisel erişim token'larında token scope'u da kontrol edilir.
+func (s *userService) canGrantRoles(ctx context.Context) (bool, error) {
+	actorRole := utils.GetRoleFromContext(ctx)
+	allowed, err := s.permissions.HasPermission(ctx, actorRole, roleManagePermission)
+	if err != nil {
+		s.logger.Error("Failed to check role grant permission", err, map[string]interface{}{
+			"role": actorRole,
+		})
+		return false, err
+	}
+	if scopes, isPAT := utils.GetTokenScopesFromContext(ctx); isPAT && !slices.Contains(scopes, roleManagePermission) {
+		return false, nil
+	}
+	return allowed, nil
+}
+
+// CheckManageable kullanıcının hesabına yönetici işlemleri (2FA sıfırlama, oturum sonlandırma)
+// uygulanabileceğini doğrular; auth modülü tarafından kullanılır.
+func (s *userService) CheckManageable(ctx context.Context, id uuid.UUID) error {
+	user, err := s.getUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.checkManage(ctx, user)
+}
+
+// checkManage varsayılan rol dışındaki kullanıcıların hesaplarının sadece role:manage
+// yetkisi olan kullanıcılar tarafından yönetilmesini sağlar. Aksi halde user:manage yetkisi
+// bir ADMIN hesabını ele geçirmeye yeterdi.
+func (s *userService) checkManage(ctx context.Context, user *domain.User) error {
+	if user.Role == defaultRole {
+		return nil
+	}
+
+	allowed, err := s.canGrantRoles(ctx)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		s.logger.Info("User management rejected", map[string]interface{}{
+			"action":  "USER_MANAGE_REJECTED",
+			"actor":   utils.GetUsernameFromContext(ctx),
+			"user_id": user.Id.String(),
+			"role":    user.Role,
+		})
+		return domain.ErrManageForbidden{Role: user.Role}
+	}
+	return nil
+}
+
+// ensureOtherAdmin aktif bir ADMIN'in rolü veya durumu değişmeden önce başka bir aktif
+// ADMIN kaldığını doğrular.
+func (s *userService) ensureOtherAdmin(ctx context.Context) error {
+	admins, err := s.repo.CountByRole(ctx, adminRole)
+	if err != nil {
+		s.logger.Error("Failed to count admin users", err, nil)
+		return err
+	}
+	if admins <= 1 {
+		return domain.ErrLastAdmin{}
+	}
+	return nil
+}
+
+func (s *userService) getUser(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	user, err := s.repo.GetByUserID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrUserNotFound{}
+		}
+		s.logger.Error("Failed to get user by ID", err, map[string]interface{}{
+			"user_id": id.String(),
+		})
+		return nil, err
+	}
+	return user, nil
+}